@@ -0,0 +1,36 @@
+package validator
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// CompareDuration returns -1, 0 or +1 depending on whether the google.protobuf.Duration is shorter than, equal to or
+// longer than the other one. Nanos of the opposite sign of the seconds are accounted for.
+func CompareDuration(seconds int64, nanos int32, otherSeconds int64, otherNanos int32) int {
+	seconds, nanos = normalizeDuration(seconds, nanos)
+	otherSeconds, otherNanos = normalizeDuration(otherSeconds, otherNanos)
+	return CompareTimestamp(seconds, nanos, otherSeconds, otherNanos)
+}
+
+// FormatDuration formats the duration like time.Duration, e.g. 1m30s, or as its seconds and nanos when it does not
+// fit in a time.Duration.
+func FormatDuration(seconds int64, nanos int32) string {
+	normalizedSeconds, normalizedNanos := normalizeDuration(seconds, nanos)
+	if normalizedSeconds < math.MinInt64/int64(time.Second) || normalizedSeconds >= math.MaxInt64/int64(time.Second) {
+		return fmt.Sprintf("seconds:%d nanos:%d", seconds, nanos)
+	}
+	return (time.Duration(normalizedSeconds)*time.Second + time.Duration(normalizedNanos)).String()
+}
+
+// normalizeDuration returns the duration with its nanos between 0 and 999999999, e.g. -2s and 500000000ns for -1.5s.
+func normalizeDuration(seconds int64, nanos int32) (int64, int32) {
+	seconds += int64(nanos / 1e9)
+	nanos %= 1e9
+	if nanos < 0 {
+		seconds--
+		nanos += 1e9
+	}
+	return seconds, nanos
+}
@@ -0,0 +1,947 @@
+// Package dynamic validates messages against their (validator.field) and (validator.oneof) options through protobuf
+// reflection. It is meant for messages whose descriptors are only known at runtime (e.g. loaded from a
+// FileDescriptorSet) and that therefore have no generated Validate() method. The returned violations are the same
+// as the ones of the generated code.
+package dynamic
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gogo/protobuf/gogoproto"
+	gogo "github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	validator "github.com/lucianoapolo/go-proto-validators"
+	"github.com/lucianoapolo/go-proto-validators/internal/messages"
+)
+
+// Validator validates messages using the options of their descriptors.
+type Validator struct {
+	lang    string
+	fields  sync.Map // protoreflect.FieldDescriptor -> *fieldRules
+	oneofs  sync.Map // protoreflect.OneofDescriptor -> *validator.OneofValidator
+	regexes sync.Map // string -> *regexp.Regexp
+}
+
+type fieldRules struct {
+	validators []*validator.FieldValidator
+	nullable   bool
+}
+
+// The languages violations can be described in, the same as the lang parameter of the plugin.
+const (
+	LangPtBr    = messages.LangPtBr
+	LangDefault = messages.LangDefault
+)
+
+// NewValidator returns a Validator describing violations in the given language, see LangDefault and LangPtBr.
+func NewValidator(lang string) *Validator {
+	switch strings.ToLower(lang) {
+	case LangPtBr:
+		lang = LangPtBr
+	default:
+		lang = LangDefault
+	}
+	return &Validator{lang: lang}
+}
+
+// Validate returns the violations of the message rules, or nil if the message is valid.
+func (v *Validator) Validate(msg protoreflect.Message) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	md := msg.Descriptor()
+	proto3 := md.Syntax() == protoreflect.Proto3
+
+	if proto3 {
+		for i := 0; i < md.Oneofs().Len(); i++ {
+			oneof := md.Oneofs().Get(i)
+			if v.oneofValidator(oneof).GetRequired() && msg.WhichOneof(oneof) == nil {
+				fieldViolation := &errdetails.BadRequest_FieldViolation{Field: camelCase(string(oneof.Name())), Description: v.message("oneof_required")}
+				fieldsViolations = append(fieldsViolations, fieldViolation)
+			}
+		}
+	}
+	for i := 0; i < md.Fields().Len(); i++ {
+		field := md.Fields().Get(i)
+		rules := v.fieldRules(field)
+		isMessage := isMessage(field)
+		if len(rules.validators) == 0 && !isMessage {
+			continue
+		}
+		if field.ContainingOneof() != nil && !msg.Has(field) {
+			continue
+		}
+		fieldName := camelCase(string(field.Name()))
+		if field.IsMap() {
+			fieldsViolations = append(fieldsViolations, v.validateMap(field, msg.Get(field).Map(), fieldName, rules.validators)...)
+			continue
+		}
+		if field.IsList() {
+			list := msg.Get(field).List()
+			fieldsViolations = append(fieldsViolations, v.validateRepeatedCount(field, list, fieldName, rules.validators)...)
+			for j := 0; j < list.Len(); j++ {
+				// Nil message elements are skipped, like the generated code does.
+				if isMessage && !list.Get(j).Message().IsValid() {
+					continue
+				}
+				itemPath := fmt.Sprintf("%s[%d]", fieldName, j)
+				for _, fv := range rules.validators {
+					fieldsViolations = append(fieldsViolations, v.validateField(field, list.Get(j), itemPath, fv)...)
+				}
+				if isMessage {
+					fieldsViolations = append(fieldsViolations, v.validateChild(list.Get(j).Message(), itemPath)...)
+				}
+			}
+			continue
+		}
+		if isMessage {
+			if msg.Has(field) {
+				for _, fv := range rules.validators {
+					fieldsViolations = append(fieldsViolations, v.validateField(field, msg.Get(field), fieldName, fv)...)
+				}
+			}
+			if proto3 {
+				fieldsViolations = append(fieldsViolations, v.validateMessageExists(msg, field, fieldName, rules.validators)...)
+			}
+			if msg.Has(field) {
+				fieldsViolations = append(fieldsViolations, v.validateChild(msg.Get(field).Message(), fieldName)...)
+			}
+			continue
+		}
+		// Only proto2 fields with (gogoproto.nullable) = false are validated when unset.
+		if !proto3 && rules.nullable && !msg.Has(field) {
+			continue
+		}
+		for _, fv := range rules.validators {
+			fieldsViolations = append(fieldsViolations, v.validateField(field, msg.Get(field), fieldName, fv)...)
+		}
+	}
+	if len(fieldsViolations) > 0 {
+		return fieldsViolations
+	}
+	return nil
+}
+
+func (v *Validator) validateChild(msg protoreflect.Message, fieldPath string) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolationsChild := v.Validate(msg)
+	for i, fv := range fieldsViolationsChild {
+		fieldsViolationsChild[i] = &errdetails.BadRequest_FieldViolation{Field: fieldPath + "." + fv.Field, Description: fv.Description}
+	}
+	return fieldsViolationsChild
+}
+
+func (v *Validator) validateMessageExists(msg protoreflect.Message, field protoreflect.FieldDescriptor, fieldName string, validators []*validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	for _, fv := range validators {
+		if fv.GetMsgExists() && !msg.Has(field) {
+			fieldsViolations = append(fieldsViolations, v.violationEmpty(fieldName, v.message("msg_exists"), fv))
+		}
+		if another := fv.GetMsgExistsIfAnotherNot(); another != "" && !msg.Has(field) {
+			anotherField := fieldByGoName(msg.Descriptor(), another)
+			if anotherField == nil || !msg.Has(anotherField) {
+				errorStr := fmt.Sprintf(v.message("msg_exists_if_another_not"), another)
+				fieldsViolations = append(fieldsViolations, v.violationEmpty(fieldName, errorStr, fv))
+			}
+		}
+	}
+	return fieldsViolations
+}
+
+func (v *Validator) validateRepeatedCount(field protoreflect.FieldDescriptor, list protoreflect.List, fieldName string, validators []*validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	for _, fv := range validators {
+		if fv.RepeatedCountMin != nil && int64(list.Len()) < fv.GetRepeatedCountMin() {
+			errorStr := fmt.Sprintf(v.message("repeated_count_min"), fv.GetRepeatedCountMin())
+			fieldsViolations = append(fieldsViolations, v.violation(fieldName, errorStr, listValue(field, list), fv))
+		}
+		if fv.RepeatedCountMax != nil && int64(list.Len()) > fv.GetRepeatedCountMax() {
+			errorStr := fmt.Sprintf(v.message("repeated_count_max"), fv.GetRepeatedCountMax())
+			fieldsViolations = append(fieldsViolations, v.violation(fieldName, errorStr, listValue(field, list), fv))
+		}
+	}
+	return fieldsViolations
+}
+
+func (v *Validator) validateMap(field protoreflect.FieldDescriptor, entries protoreflect.Map, fieldName string, validators []*validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	for _, fv := range validators {
+		if fv.MapCountMin != nil && int64(entries.Len()) < fv.GetMapCountMin() {
+			errorStr := fmt.Sprintf(v.message("map_count_min"), fv.GetMapCountMin())
+			fieldsViolations = append(fieldsViolations, v.violation(fieldName, errorStr, mapValue(field, entries), fv))
+		}
+		if fv.MapCountMax != nil && int64(entries.Len()) > fv.GetMapCountMax() {
+			errorStr := fmt.Sprintf(v.message("map_count_max"), fv.GetMapCountMax())
+			fieldsViolations = append(fieldsViolations, v.violation(fieldName, errorStr, mapValue(field, entries), fv))
+		}
+	}
+	// Keys are rendered the way they would be written in a Go map literal, e.g. SomeMap["abc"] or SomeMap[3].
+	keyVerb := "%v"
+	if field.MapKey().Kind() == protoreflect.StringKind {
+		keyVerb = "%q"
+	}
+	entries.Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+		entryPath := fmt.Sprintf(fieldName+"["+keyVerb+"]", key.Interface())
+		// Nil message values are skipped, like the generated code does.
+		hasValue := !isMessage(field.MapValue()) || value.Message().IsValid()
+		for _, fv := range validators {
+			if fv.MapKey != nil {
+				fieldsViolations = append(fieldsViolations, v.validateField(field.MapKey(), key.Value(), entryPath, fv.MapKey)...)
+			}
+			if fv.MapValue.GetMsgExists() && !hasValue {
+				fieldsViolations = append(fieldsViolations, v.violationEmpty(entryPath, v.message("msg_exists"), fv.MapValue))
+			}
+			if fv.MapValue != nil && hasValue {
+				fieldsViolations = append(fieldsViolations, v.validateField(field.MapValue(), value, entryPath, fv.MapValue)...)
+			}
+		}
+		if isMessage(field.MapValue()) && hasValue {
+			fieldsViolations = append(fieldsViolations, v.validateChild(value.Message(), entryPath)...)
+		}
+		return true
+	})
+	return fieldsViolations
+}
+
+func (v *Validator) validateField(field protoreflect.FieldDescriptor, value protoreflect.Value, fieldPath string, fv *validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	switch {
+	case field.Kind() == protoreflect.StringKind:
+		return v.validateString(field, value, fieldPath, fv)
+	case isSupportedInt(field):
+		return v.validateInt(field, value, fieldPath, fv)
+	case field.Kind() == protoreflect.EnumKind:
+		return v.validateEnum(field, value, fieldPath, fv)
+	case isSupportedFloat(field):
+		return v.validateFloat(field, value, fieldPath, fv)
+	case field.Kind() == protoreflect.BoolKind:
+		if fv.BoolConst != nil && value.Bool() != fv.GetBoolConst() {
+			return []*errdetails.BadRequest_FieldViolation{v.constViolation("bool_const", field, value, fieldPath, fmt.Sprint(fv.GetBoolConst()), fv)}
+		}
+	case isWrapper(field):
+		valueField := field.Message().Fields().ByName("value")
+		return v.validateField(valueField, value.Message().Get(valueField), fieldPath, fv)
+	case isTimestamp(field):
+		return v.validateTimestamp(value.Message(), fieldPath, fv)
+	case isDuration(field):
+		return v.validateDuration(value.Message(), fieldPath, fv)
+	case field.Kind() == protoreflect.BytesKind:
+		fieldsViolations := v.validateSubstrings(field, value, string(value.Bytes()), fieldPath, fv)
+		return append(fieldsViolations, v.validateLength(field, value, len(value.Bytes()), fieldPath, fv)...)
+	}
+	return nil
+}
+
+func (v *Validator) validateString(field protoreflect.FieldDescriptor, value protoreflect.Value, fieldPath string, fv *validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	if fv.Regex != nil || fv.UuidVer != nil {
+		pattern := fv.GetRegex()
+		if fv.UuidVer != nil {
+			if uuid, err := messages.UUIDRegex(fv.GetUuidVer()); err == nil {
+				pattern = uuid
+			}
+		}
+		if !v.regex(pattern).MatchString(value.String()) {
+			errorStr := v.message("regex") + strconv.Quote(pattern)
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+		}
+	}
+	if fv.GetEmail() && !validator.IsEmail(value.String(), fv.GetEmailRejectDisplayName(), fv.GetEmailRequireDottedDomain()) {
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, v.message("email"), goValue(field, value), fv))
+	}
+	formats := []struct {
+		enabled bool
+		valid   func(string) bool
+		rule    string
+	}{
+		{fv.GetHostname(), validator.IsHostname, "hostname"},
+		{fv.GetIp(), validator.IsIP, "ip"},
+		{fv.GetIpv4(), validator.IsIPv4, "ipv4"},
+		{fv.GetIpv6(), validator.IsIPv6, "ipv6"},
+		{fv.GetCidr(), validator.IsCIDR, "cidr"},
+	}
+	for _, format := range formats {
+		if format.enabled && !format.valid(value.String()) {
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, v.message(format.rule), goValue(field, value), fv))
+		}
+	}
+	if fv.GetUri() || fv.GetUriRef() {
+		errorOptions := ""
+		if len(fv.UriSchemes) > 0 {
+			errorOptions += fmt.Sprintf(v.message("uri_schemes"), valueList(quoteStrings(fv.UriSchemes)))
+		}
+		if fv.GetUriRequireHost() {
+			errorOptions += v.message("uri_require_host")
+		}
+		if fv.GetUriForbidUserinfo() {
+			errorOptions += v.message("uri_forbid_userinfo")
+		}
+		if fv.GetUri() && !validator.IsURI(value.String(), fv.UriSchemes, fv.GetUriRequireHost(), fv.GetUriForbidUserinfo()) {
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, v.message("uri")+errorOptions, goValue(field, value), fv))
+		}
+		if fv.GetUriRef() && !validator.IsURIRef(value.String(), fv.UriSchemes, fv.GetUriRequireHost(), fv.GetUriForbidUserinfo()) {
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, v.message("uri_ref")+errorOptions, goValue(field, value), fv))
+		}
+	}
+	if fv.GetStringNotEmpty() && value.String() == "" {
+		fieldsViolations = append(fieldsViolations, v.violationEmpty(fieldPath, v.message("string_not_empty"), fv))
+	}
+	if fv.GetTrimmedStringNotEmpty() && strings.TrimSpace(value.String()) == "" {
+		fieldsViolations = append(fieldsViolations, v.violationEmpty(fieldPath, v.message("trimmed_string_not_empty"), fv))
+	}
+	if fv.StringConst != nil && value.String() != fv.GetStringConst() {
+		fieldsViolations = append(fieldsViolations, v.constViolation("string_const", field, value, fieldPath, strconv.Quote(fv.GetStringConst()), fv))
+	}
+	if len(fv.StringIn) > 0 && !containsString(fv.StringIn, value.String()) {
+		errorStr := fmt.Sprintf(v.message("string_in"), valueList(quoteStrings(fv.StringIn)))
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if len(fv.StringNotIn) > 0 && containsString(fv.StringNotIn, value.String()) {
+		errorStr := fmt.Sprintf(v.message("string_not_in"), valueList(quoteStrings(fv.StringNotIn)))
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	fieldsViolations = append(fieldsViolations, v.validateSubstrings(field, value, value.String(), fieldPath, fv)...)
+	fieldsViolations = append(fieldsViolations, v.validateLength(field, value, len(value.String()), fieldPath, fv)...)
+	return append(fieldsViolations, v.validateRunes(field, value, fieldPath, fv)...)
+}
+
+func (v *Validator) validateRunes(field protoreflect.FieldDescriptor, value protoreflect.Value, fieldPath string, fv *validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	if fv.GetValidUtf8() && !utf8.ValidString(value.String()) {
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, v.message("valid_utf8"), goValue(field, value), fv))
+	}
+	count := int64(utf8.RuneCountInString(value.String()))
+	if fv.RunesMin != nil && !(count >= fv.GetRunesMin()) {
+		errorStr := fmt.Sprintf(v.message("runes_min"), fv.GetRunesMin())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if fv.RunesMax != nil && !(count <= fv.GetRunesMax()) {
+		errorStr := fmt.Sprintf(v.message("runes_max"), fv.GetRunesMax())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if fv.RunesEq != nil && !(count == fv.GetRunesEq()) {
+		errorStr := fmt.Sprintf(v.message("runes_eq"), fv.GetRunesEq())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	return fieldsViolations
+}
+
+func (v *Validator) validateSubstrings(field protoreflect.FieldDescriptor, value protoreflect.Value, content string, fieldPath string, fv *validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	rules := []struct {
+		value *string
+		valid func(string, string) bool
+		rule  string
+	}{
+		{fv.Prefix, strings.HasPrefix, "prefix"},
+		{fv.Suffix, strings.HasSuffix, "suffix"},
+		{fv.Contains, strings.Contains, "contains"},
+		{fv.NotContains, func(s, substr string) bool { return !strings.Contains(s, substr) }, "not_contains"},
+	}
+	for _, rule := range rules {
+		if rule.value != nil && !rule.valid(content, *rule.value) {
+			errorStr := fmt.Sprintf(v.message(rule.rule), strings.Replace(strconv.Quote(*rule.value), "%", "%%", -1))
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+		}
+	}
+	return fieldsViolations
+}
+
+func (v *Validator) validateLength(field protoreflect.FieldDescriptor, value protoreflect.Value, length int, fieldPath string, fv *validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	if fv.LengthGt != nil && !(int64(length) > fv.GetLengthGt()) {
+		errorStr := fmt.Sprintf(v.message("length_gt"), fv.GetLengthGt())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if fv.LengthLt != nil && !(int64(length) < fv.GetLengthLt()) {
+		errorStr := fmt.Sprintf(v.message("length_lt"), fv.GetLengthLt())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if fv.LengthEq != nil && !(int64(length) == fv.GetLengthEq()) {
+		errorStr := fmt.Sprintf(v.message("length_eq"), fv.GetLengthEq())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	return fieldsViolations
+}
+
+func (v *Validator) validateInt(field protoreflect.FieldDescriptor, value protoreflect.Value, fieldPath string, fv *validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	if fv.IntGt != nil && !(compareInt(field, value, fv.GetIntGt()) > 0) {
+		errorStr := fmt.Sprintf(v.message("int_gt"), fv.GetIntGt())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if fv.IntLt != nil && !(compareInt(field, value, fv.GetIntLt()) < 0) {
+		errorStr := fmt.Sprintf(v.message("int_lt"), fv.GetIntLt())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if fv.IntGte != nil && !(compareInt(field, value, fv.GetIntGte()) >= 0) {
+		errorStr := fmt.Sprintf(v.message("int_gte"), fv.GetIntGte())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if fv.IntLte != nil && !(compareInt(field, value, fv.GetIntLte()) <= 0) {
+		errorStr := fmt.Sprintf(v.message("int_lte"), fv.GetIntLte())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if isUnsigned(field) {
+		if fv.UintGt != nil && !(value.Uint() > fv.GetUintGt()) {
+			errorStr := fmt.Sprintf(v.message("uint_gt"), fv.GetUintGt())
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+		}
+		if fv.UintLt != nil && !(value.Uint() < fv.GetUintLt()) {
+			errorStr := fmt.Sprintf(v.message("uint_lt"), fv.GetUintLt())
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+		}
+		if fv.UintGte != nil && !(value.Uint() >= fv.GetUintGte()) {
+			errorStr := fmt.Sprintf(v.message("uint_gte"), fv.GetUintGte())
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+		}
+		if fv.UintLte != nil && !(value.Uint() <= fv.GetUintLte()) {
+			errorStr := fmt.Sprintf(v.message("uint_lte"), fv.GetUintLte())
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+		}
+	}
+	if fv.IntMultipleOf != nil && fv.GetIntMultipleOf() != 0 && !isMultipleOf(field, value, fv.GetIntMultipleOf()) {
+		errorStr := fmt.Sprintf(v.message("int_multiple_of"), fv.GetIntMultipleOf())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if fv.IntConst != nil && compareInt(field, value, fv.GetIntConst()) != 0 {
+		fieldsViolations = append(fieldsViolations, v.constViolation("int_const", field, value, fieldPath, strconv.FormatInt(fv.GetIntConst(), 10), fv))
+	}
+	if len(fv.IntIn) > 0 && !containsInt(field, value, fv.IntIn) {
+		errorStr := fmt.Sprintf(v.message("int_in"), valueList(formatInts(fv.IntIn)))
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if len(fv.IntNotIn) > 0 && containsInt(field, value, fv.IntNotIn) {
+		errorStr := fmt.Sprintf(v.message("int_not_in"), valueList(formatInts(fv.IntNotIn)))
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	return fieldsViolations
+}
+
+func (v *Validator) validateEnum(field protoreflect.FieldDescriptor, value protoreflect.Value, fieldPath string, fv *validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	if fv.GetIsInEnum() && field.Enum().Values().ByNumber(value.Enum()) == nil {
+		errorStr := fmt.Sprintf(v.message("is_in_enum"), goTypeName(field.Enum()))
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if fv.EnumConst != nil && int32(value.Enum()) != fv.GetEnumConst() {
+		display := fmt.Sprint(fv.GetEnumConst())
+		if enumValue := field.Enum().Values().ByNumber(protoreflect.EnumNumber(fv.GetEnumConst())); enumValue != nil {
+			display = string(enumValue.Name())
+		}
+		fieldsViolations = append(fieldsViolations, v.constViolation("enum_const", field, value, fieldPath, display, fv))
+	}
+	return fieldsViolations
+}
+
+func (v *Validator) validateFloat(field protoreflect.FieldDescriptor, value protoreflect.Value, fieldPath string, fv *validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	if x, _ := floatOperands(field, value, 0, 0); fv.GetFloatFinite() && (math.IsNaN(x) || math.IsInf(x, 0)) {
+		return append(fieldsViolations, v.violation(fieldPath, v.message("float_finite"), goValue(field, value), fv))
+	} else if fv.GetFloatNotNan() && math.IsNaN(x) {
+		return append(fieldsViolations, v.violation(fieldPath, v.message("float_not_nan"), goValue(field, value), fv))
+	}
+	upperIsStrict := true
+	lowerIsStrict := true
+
+	// Determine the real limits the same way the plugin does when both strict and non-strict bounds are set.
+	if fv.FloatLt != nil && fv.FloatLte != nil {
+		strictLimit := fv.GetFloatLt()
+		if fv.FloatEpsilon != nil {
+			strictLimit += fv.GetFloatEpsilon()
+		}
+		if fv.GetFloatLte() < strictLimit {
+			upperIsStrict = false
+		}
+	} else if fv.FloatLte != nil {
+		upperIsStrict = false
+	}
+	if fv.FloatGt != nil && fv.FloatGte != nil {
+		strictLimit := fv.GetFloatGt()
+		if fv.FloatEpsilon != nil {
+			strictLimit -= fv.GetFloatEpsilon()
+		}
+		if fv.GetFloatGte() > strictLimit {
+			lowerIsStrict = false
+		}
+	} else if fv.FloatGte != nil {
+		lowerIsStrict = false
+	}
+
+	if fv.FloatGt != nil || fv.FloatGte != nil {
+		var errorStr string
+		var valid bool
+		if lowerIsStrict {
+			errorStr = fmt.Sprintf(v.message("float_gt"), fv.GetFloatGt())
+			if fv.FloatEpsilon != nil {
+				errorStr += fmt.Sprintf(v.message("float_gt_epsilon"), fv.GetFloatEpsilon())
+			}
+			x, limit := floatOperands(field, value, fv.GetFloatEpsilon(), fv.GetFloatGt())
+			valid = x > limit
+		} else {
+			errorStr = fmt.Sprintf(v.message("float_gte"), fv.GetFloatGte())
+			x, limit := floatOperands(field, value, 0, fv.GetFloatGte())
+			valid = x >= limit
+		}
+		if !valid {
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+		}
+	}
+	if fv.FloatLt != nil || fv.FloatLte != nil {
+		var errorStr string
+		var valid bool
+		if upperIsStrict {
+			errorStr = fmt.Sprintf(v.message("float_lt"), fv.GetFloatLt())
+			if fv.FloatEpsilon != nil {
+				errorStr += fmt.Sprintf(v.message("float_lt_epsilon"), fv.GetFloatEpsilon())
+			}
+			x, limit := floatOperands(field, value, -fv.GetFloatEpsilon(), fv.GetFloatLt())
+			valid = x < limit
+		} else {
+			errorStr = fmt.Sprintf(v.message("float_lte"), fv.GetFloatLte())
+			x, limit := floatOperands(field, value, 0, fv.GetFloatLte())
+			valid = x <= limit
+		}
+		if !valid {
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+		}
+	}
+	if fv.FloatConst != nil && !math.IsNaN(fv.GetFloatConst()) && !math.IsInf(fv.GetFloatConst(), 0) {
+		if x, constant := floatOperands(field, value, 0, fv.GetFloatConst()); x != constant {
+			fieldsViolations = append(fieldsViolations, v.constViolation("float_const", field, value, fieldPath, fmt.Sprint(fv.GetFloatConst()), fv))
+		}
+	}
+	bitSize := 64
+	if field.Kind() == protoreflect.FloatKind {
+		bitSize = 32
+	}
+	if fv.FloatMultipleOf != nil {
+		if !validator.IsMultipleOf(value.Float(), fv.GetFloatMultipleOf(), fv.GetFloatEpsilon(), bitSize) {
+			errorStr := fmt.Sprintf(v.message("float_multiple_of"), fv.GetFloatMultipleOf())
+			if fv.FloatEpsilon != nil {
+				errorStr += fmt.Sprintf(v.message("float_multiple_of_epsilon"), fv.GetFloatEpsilon())
+			}
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+		}
+	}
+	if fv.DecimalPlacesLte != nil && !(validator.DecimalPlaces(value.Float(), bitSize) <= int(fv.GetDecimalPlacesLte())) {
+		errorStr := fmt.Sprintf(v.message("decimal_places_lte"), fv.GetDecimalPlacesLte())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if fv.DecimalPlacesGte != nil && !(validator.DecimalPlaces(value.Float(), bitSize) >= int(fv.GetDecimalPlacesGte())) {
+		errorStr := fmt.Sprintf(v.message("decimal_places_gte"), fv.GetDecimalPlacesGte())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	if fv.SignificantDigitsLte != nil && !(validator.SignificantDigits(value.Float(), bitSize) <= int(fv.GetSignificantDigitsLte())) {
+		errorStr := fmt.Sprintf(v.message("significant_digits_lte"), fv.GetSignificantDigitsLte())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, goValue(field, value), fv))
+	}
+	return fieldsViolations
+}
+
+// validateTimestamp reports the timestamp out of range alone, like the generated code.
+func (v *Validator) validateTimestamp(value protoreflect.Message, fieldPath string, fv *validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	hasBounds := fv.TimestampLt != nil || fv.TimestampGt != nil || fv.GetTimestampLtNow() || fv.GetTimestampGtNow() || fv.TimestampWithin != nil
+	if !hasBounds && !fv.GetTimestampValid() {
+		return nil
+	}
+	fields := value.Descriptor().Fields()
+	seconds, nanos := value.Get(fields.ByName("seconds")).Int(), int32(value.Get(fields.ByName("nanos")).Int())
+	display := validator.FormatTimestamp(seconds, nanos)
+	if !validator.IsValidTimestamp(seconds, nanos) {
+		return []*errdetails.BadRequest_FieldViolation{v.violation(fieldPath, v.message("timestamp_valid"), display, fv)}
+	}
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	if limit, err := time.Parse(time.RFC3339Nano, fv.GetTimestampLt()); fv.TimestampLt != nil && err == nil &&
+		!(validator.CompareTimestamp(seconds, nanos, limit.Unix(), int32(limit.Nanosecond())) < 0) {
+		errorStr := fmt.Sprintf(v.message("timestamp_lt"), fv.GetTimestampLt())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, display, fv))
+	}
+	if limit, err := time.Parse(time.RFC3339Nano, fv.GetTimestampGt()); fv.TimestampGt != nil && err == nil &&
+		!(validator.CompareTimestamp(seconds, nanos, limit.Unix(), int32(limit.Nanosecond())) > 0) {
+		errorStr := fmt.Sprintf(v.message("timestamp_gt"), fv.GetTimestampGt())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, display, fv))
+	}
+	if fv.GetTimestampLtNow() && !(validator.CompareTimestampToNow(seconds, nanos) < 0) {
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, v.message("timestamp_lt_now"), display, fv))
+	}
+	if fv.GetTimestampGtNow() && !(validator.CompareTimestampToNow(seconds, nanos) > 0) {
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, v.message("timestamp_gt_now"), display, fv))
+	}
+	if within, err := time.ParseDuration(fv.GetTimestampWithin()); fv.TimestampWithin != nil && err == nil &&
+		!validator.IsTimestampWithin(seconds, nanos, within) {
+		errorStr := fmt.Sprintf(v.message("timestamp_within"), fv.GetTimestampWithin())
+		fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, display, fv))
+	}
+	return fieldsViolations
+}
+
+func (v *Validator) validateDuration(value protoreflect.Message, fieldPath string, fv *validator.FieldValidator) []*errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*errdetails.BadRequest_FieldViolation{}
+	fields := value.Descriptor().Fields()
+	seconds, nanos := value.Get(fields.ByName("seconds")).Int(), int32(value.Get(fields.ByName("nanos")).Int())
+	display := validator.FormatDuration(seconds, nanos)
+	compare := func(limit time.Duration) int {
+		return validator.CompareDuration(seconds, nanos, int64(limit/time.Second), int32(limit%time.Second))
+	}
+	for _, bound := range []struct {
+		value *string
+		rule  string
+		valid func(int) bool
+	}{
+		{fv.DurationLt, "duration_lt", func(c int) bool { return c < 0 }},
+		{fv.DurationLte, "duration_lte", func(c int) bool { return c <= 0 }},
+		{fv.DurationGt, "duration_gt", func(c int) bool { return c > 0 }},
+		{fv.DurationGte, "duration_gte", func(c int) bool { return c >= 0 }},
+	} {
+		if bound.value == nil {
+			continue
+		}
+		if limit, err := time.ParseDuration(*bound.value); err == nil && !bound.valid(compare(limit)) {
+			errorStr := fmt.Sprintf(v.message(bound.rule), messages.FormatDuration(limit, v.lang))
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, display, fv))
+		}
+	}
+	if len(fv.DurationIn) > 0 {
+		described := make([]string, 0, len(fv.DurationIn))
+		found := false
+		for _, value := range fv.DurationIn {
+			limit, err := time.ParseDuration(value)
+			if err != nil {
+				return fieldsViolations
+			}
+			described = append(described, messages.FormatDuration(limit, v.lang))
+			found = found || compare(limit) == 0
+		}
+		if !found {
+			errorStr := fmt.Sprintf(v.message("duration_in"), valueList(described))
+			fieldsViolations = append(fieldsViolations, v.violation(fieldPath, errorStr, display, fv))
+		}
+	}
+	return fieldsViolations
+}
+
+func (v *Validator) violation(fieldPath string, specificError string, value interface{}, fv *validator.FieldValidator) *errdetails.BadRequest_FieldViolation {
+	if fv.GetHumanError() != "" {
+		return &errdetails.BadRequest_FieldViolation{Field: fieldPath, Description: fv.GetHumanError()}
+	}
+	return &errdetails.BadRequest_FieldViolation{Field: fieldPath, Description: fmt.Sprintf(v.message("value")+specificError, value)}
+}
+
+func (v *Validator) constViolation(rule string, field protoreflect.FieldDescriptor, value protoreflect.Value, fieldPath string, display string, fv *validator.FieldValidator) *errdetails.BadRequest_FieldViolation {
+	errorStr := fmt.Sprintf(v.message(rule), strings.Replace(display, "%", "%%", -1))
+	return v.violation(fieldPath, errorStr, goValue(field, value), fv)
+}
+
+func (v *Validator) violationEmpty(fieldPath string, specificError string, fv *validator.FieldValidator) *errdetails.BadRequest_FieldViolation {
+	if fv.GetHumanError() != "" {
+		return &errdetails.BadRequest_FieldViolation{Field: fieldPath, Description: fv.GetHumanError()}
+	}
+	return &errdetails.BadRequest_FieldViolation{Field: fieldPath, Description: specificError}
+}
+
+func (v *Validator) message(rule string) string {
+	return messages.ForRule(rule, v.lang)
+}
+
+// regex compiles and caches the pattern. A pattern that does not compile never matches, so that the rule fails
+// closed instead of panicking like the regexp.MustCompile of the generated code.
+func (v *Validator) regex(pattern string) *regexp.Regexp {
+	if re, ok := v.regexes.Load(pattern); ok {
+		return re.(*regexp.Regexp)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = regexp.MustCompile(`[^\s\S]`)
+	}
+	v.regexes.Store(pattern, re)
+	return re
+}
+
+func (v *Validator) fieldRules(field protoreflect.FieldDescriptor) *fieldRules {
+	if rules, ok := v.fields.Load(field); ok {
+		return rules.(*fieldRules)
+	}
+	options := &descriptor.FieldOptions{}
+	unmarshalOptions(field.Options(), options)
+	rules := &fieldRules{nullable: gogoproto.IsNullable(&descriptor.FieldDescriptorProto{Options: options})}
+	if ext, err := gogo.GetExtension(options, validator.E_Field); err == nil {
+		rules.validators, _ = ext.([]*validator.FieldValidator)
+	}
+	v.fields.Store(field, rules)
+	return rules
+}
+
+func (v *Validator) oneofValidator(oneof protoreflect.OneofDescriptor) *validator.OneofValidator {
+	if ov, ok := v.oneofs.Load(oneof); ok {
+		return ov.(*validator.OneofValidator)
+	}
+	options := &descriptor.OneofOptions{}
+	unmarshalOptions(oneof.Options(), options)
+	var ov *validator.OneofValidator
+	if ext, err := gogo.GetExtension(options, validator.E_Oneof); err == nil {
+		ov, _ = ext.(*validator.OneofValidator)
+	}
+	v.oneofs.Store(oneof, ov)
+	return ov
+}
+
+// unmarshalOptions converts descriptor options into their gogo counterpart, which is what the validator extensions
+// are registered against. This works whether or not the extensions are known to the protobuf registry.
+func unmarshalOptions(options proto.Message, gogoOptions gogo.Message) {
+	data, err := proto.Marshal(options)
+	if err != nil {
+		return
+	}
+	_ = gogo.Unmarshal(data, gogoOptions)
+}
+
+func fieldByGoName(md protoreflect.MessageDescriptor, goName string) protoreflect.FieldDescriptor {
+	for i := 0; i < md.Fields().Len(); i++ {
+		field := md.Fields().Get(i)
+		if camelCase(string(field.Name())) == goName || string(field.Name()) == goName {
+			return field
+		}
+	}
+	return nil
+}
+
+func isMessage(field protoreflect.FieldDescriptor) bool {
+	return field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind
+}
+
+// isWrapper tells whether the field is of one of the google.protobuf wrapper types, e.g. google.protobuf.Int64Value,
+// whose rules apply to their value.
+func isWrapper(field protoreflect.FieldDescriptor) bool {
+	if field.Kind() != protoreflect.MessageKind {
+		return false
+	}
+	switch field.Message().FullName() {
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue", "google.protobuf.Int64Value", "google.protobuf.UInt64Value",
+		"google.protobuf.Int32Value", "google.protobuf.UInt32Value", "google.protobuf.BoolValue", "google.protobuf.StringValue",
+		"google.protobuf.BytesValue":
+		return true
+	}
+	return false
+}
+
+// isTimestamp tells whether the field is a google.protobuf.Timestamp.
+func isTimestamp(field protoreflect.FieldDescriptor) bool {
+	return field.Kind() == protoreflect.MessageKind && field.Message().FullName() == "google.protobuf.Timestamp"
+}
+
+// isDuration tells whether the field is a google.protobuf.Duration.
+func isDuration(field protoreflect.FieldDescriptor) bool {
+	return field.Kind() == protoreflect.MessageKind && field.Message().FullName() == "google.protobuf.Duration"
+}
+
+func isSupportedInt(field protoreflect.FieldDescriptor) bool {
+	switch field.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return true
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return true
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return true
+	case protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return true
+	case protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return true
+	}
+	return false
+}
+
+func isSupportedFloat(field protoreflect.FieldDescriptor) bool {
+	switch field.Kind() {
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return true
+	}
+	return false
+}
+
+func isUnsigned(field protoreflect.FieldDescriptor) bool {
+	switch field.Kind() {
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return true
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(field protoreflect.FieldDescriptor, value protoreflect.Value, values []int64) bool {
+	for _, candidate := range values {
+		if compareInt(field, value, candidate) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// valueList formats the distinct values of an in or not_in rule the way the generated code lists them.
+func valueList(values []string) string {
+	seen := map[string]bool{}
+	unique := []string{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return strings.Replace("["+strings.Join(unique, ", ")+"]", "%", "%%", -1)
+}
+
+func quoteStrings(values []string) []string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, strconv.Quote(value))
+	}
+	return quoted
+}
+
+func formatInts(values []int64) []string {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		formatted = append(formatted, strconv.FormatInt(value, 10))
+	}
+	return formatted
+}
+
+// compareInt returns -1, 0 or +1 depending on whether the value is less than, equal to or greater than the bound.
+func compareInt(field protoreflect.FieldDescriptor, value protoreflect.Value, bound int64) int {
+	if isUnsigned(field) {
+		switch u := value.Uint(); {
+		case bound < 0 || u > uint64(bound):
+			return 1
+		case u < uint64(bound):
+			return -1
+		}
+		return 0
+	}
+	switch i := value.Int(); {
+	case i > bound:
+		return 1
+	case i < bound:
+		return -1
+	}
+	return 0
+}
+
+func isMultipleOf(field protoreflect.FieldDescriptor, value protoreflect.Value, step int64) bool {
+	if isUnsigned(field) {
+		magnitude := uint64(step)
+		if step < 0 {
+			magnitude = uint64(-(step + 1)) + 1
+		}
+		return value.Uint()%magnitude == 0
+	}
+	return value.Int()%step == 0
+}
+
+// floatOperands returns value+offset and the limit as the generated comparison computes them, i.e. with float32
+// precision for float fields.
+func floatOperands(field protoreflect.FieldDescriptor, value protoreflect.Value, offset float64, limit float64) (float64, float64) {
+	if field.Kind() == protoreflect.FloatKind {
+		return float64(float32(value.Float()) + float32(offset)), float64(float32(limit))
+	}
+	return value.Float() + offset, limit
+}
+
+// goValue returns the value as the generated struct field holds it, so that it is formatted identically.
+func goValue(field protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	if field.Kind() == protoreflect.EnumKind {
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return int32(value.Enum())
+	}
+	if isMessage(field) {
+		return value.Message().Interface()
+	}
+	return value.Interface()
+}
+
+func listValue(field protoreflect.FieldDescriptor, list protoreflect.List) []interface{} {
+	values := make([]interface{}, list.Len())
+	for i := range values {
+		values[i] = goValue(field, list.Get(i))
+	}
+	return values
+}
+
+func mapValue(field protoreflect.FieldDescriptor, entries protoreflect.Map) map[interface{}]interface{} {
+	values := make(map[interface{}]interface{}, entries.Len())
+	entries.Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+		values[key.Interface()] = goValue(field.MapValue(), value)
+		return true
+	})
+	return values
+}
+
+// goTypeName returns the name of the generated Go type of an enum, e.g. ValidatorMessage3_EmbeddedEnum.
+func goTypeName(enum protoreflect.EnumDescriptor) string {
+	name := strings.TrimPrefix(string(enum.FullName()), string(enum.ParentFile().Package())+".")
+	return strings.Replace(name, ".", "_", -1)
+}
+
+// camelCase returns the Go name of a proto field the way the generator does, e.g. "my_field_name_2" becomes
+// "MyFieldName_2", so that violations name the fields like the generated code.
+func camelCase(s string) string {
+	if s == "" {
+		return ""
+	}
+	t := make([]byte, 0, 32)
+	i := 0
+	if s[0] == '_' {
+		// Need a capital letter; drop the '_'.
+		t = append(t, 'X')
+		i++
+	}
+	// Invariant: if the next letter is lower case, it must be converted to upper case.
+	// That is, we process a word at a time, where words are marked by _ or upper case letter.
+	// Digits are treated as words.
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c == '_' && i+1 < len(s) && isASCIILower(s[i+1]) {
+			continue // Skip the underscore in s.
+		}
+		if isASCIIDigit(c) {
+			t = append(t, c)
+			continue
+		}
+		// Assume we have a letter now - if not, it's a bogus identifier.
+		// The next word is a sequence of characters that must start upper case.
+		if isASCIILower(c) {
+			c ^= ' ' // Make it a capital letter.
+		}
+		t = append(t, c) // Guaranteed not lower case.
+		// Accept lower case sequence that follows.
+		for i+1 < len(s) && isASCIILower(s[i+1]) {
+			i++
+			t = append(t, s[i])
+		}
+	}
+	return string(t)
+}
+
+func isASCIILower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
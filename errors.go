@@ -0,0 +1,68 @@
+package validator
+
+import (
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidationError is the error of a message that failed validation, wrapping its violations.
+type ValidationError struct {
+	Violations []*errdetails.BadRequest_FieldViolation
+}
+
+// NewValidationError returns a *ValidationError wrapping the violations, or nil if there are none.
+func NewValidationError(violations []*errdetails.BadRequest_FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: violations}
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, fv := range e.Violations {
+		messages = append(messages, "invalid field "+fv.Field+": "+fv.Description)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// BadRequest returns the violations as an errdetails.BadRequest.
+func (e *ValidationError) BadRequest() *errdetails.BadRequest {
+	return &errdetails.BadRequest{FieldViolations: e.Violations}
+}
+
+// GRPCStatus returns an InvalidArgument status carrying the violations as errdetails.BadRequest. It makes
+// status.FromError and status.Convert recognize the error.
+func (e *ValidationError) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, e.Error())
+	if detailed, err := st.WithDetails(e.BadRequest()); err == nil {
+		return detailed
+	}
+	return st
+}
+
+// Filter returns the violations of the field at the given path and of the fields nested in it, e.g. "Inner" keeps
+// "Inner", "Inner.SomeValue" and "Inner[2]" but not "InnerValue". It returns nil if none of the violations match.
+func (e *ValidationError) Filter(pathPrefix string) *ValidationError {
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, fv := range e.Violations {
+		if hasPathPrefix(fv.Field, pathPrefix) {
+			violations = append(violations, fv)
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: violations}
+}
+
+func hasPathPrefix(path string, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	rest := path[len(prefix):]
+	return rest == "" || prefix == "" || rest[0] == '.' || rest[0] == '['
+}
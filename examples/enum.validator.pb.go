@@ -19,7 +19,7 @@ var _ = math.Inf
 func (this *SomeMsg) Validate() []*google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation {
 	fieldsViolations := []*google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{}
 	if _, ok := Action_name[int32(this.Do)]; !ok {
-		fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "Do", Description: fmt.Sprintf(`valor '%v' deve ser um válido Action enumerador`, this.Do)}
+		fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "Do", Description: fmt.Sprintf("valor '%v' deve ser um válido Action enumerador", this.Do)}
 		fieldsViolations = append(fieldsViolations, fieldViolation)
 	}
 	if len(fieldsViolations) > 0 {
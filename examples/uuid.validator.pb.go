@@ -22,11 +22,11 @@ var _regex_UUIDMsg_UserId_00 = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{
 func (this *UUIDMsg) Validate() []*google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation {
 	fieldsViolations := []*google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{}
 	if !_regex_UUIDMsg_UserId_00.MatchString(this.UserId) {
-		fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "UserId", Description: fmt.Sprintf(`valor '%v' deve estar em conformidade com regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.UserId)}
+		fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "UserId", Description: fmt.Sprintf("valor '%v' deve estar em conformidade com regex \"^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$\"", this.UserId)}
 		fieldsViolations = append(fieldsViolations, fieldViolation)
 	}
 	if this.UserId == "" {
-		fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "UserId", Description: `deve ser preenchido`}
+		fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "UserId", Description: "deve ser preenchido"}
 		fieldsViolations = append(fieldsViolations, fieldViolation)
 	}
 	if len(fieldsViolations) > 0 {
@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"net"
+	"net/mail"
+	"net/url"
+	"strings"
+)
+
+// IsEmail tells whether the value is an email address as parsed by net/mail, e.g. "gopher@example.com" or
+// "Gopher <gopher@example.com>". rejectDisplayName only accepts bare addresses, and requireDottedDomain rejects
+// domains without a dot such as "localhost".
+func IsEmail(value string, rejectDisplayName bool, requireDottedDomain bool) bool {
+	address, err := mail.ParseAddress(value)
+	if err != nil {
+		return false
+	}
+	if rejectDisplayName && address.Address != value {
+		return false
+	}
+	if requireDottedDomain {
+		domain := address.Address[strings.LastIndex(address.Address, "@")+1:]
+		if !strings.Contains(domain, ".") || strings.HasSuffix(domain, ".") {
+			return false
+		}
+	}
+	return true
+}
+
+// IsHostname tells whether the value is a hostname following RFC 1123: dot separated labels of at most 63 letters,
+// digits and hyphens that neither start nor end with a hyphen, 253 characters at most. A trailing dot is accepted.
+func IsHostname(value string) bool {
+	value = strings.TrimSuffix(value, ".")
+	if value == "" || len(value) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(value, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsIP tells whether the value is an IPv4 or IPv6 address as parsed by net.ParseIP.
+func IsIP(value string) bool {
+	return net.ParseIP(value) != nil
+}
+
+// IsIPv4 tells whether the value is an IPv4 address in dotted decimal notation. IPv4-mapped IPv6 addresses such as
+// "::ffff:192.0.2.1" are not accepted.
+func IsIPv4(value string) bool {
+	return net.ParseIP(value) != nil && !strings.Contains(value, ":")
+}
+
+// IsIPv6 tells whether the value is an IPv6 address, including IPv4-mapped ones such as "::ffff:192.0.2.1".
+func IsIPv6(value string) bool {
+	return net.ParseIP(value) != nil && strings.Contains(value, ":")
+}
+
+// IsCIDR tells whether the value is an IP address and prefix length as parsed by net.ParseCIDR, e.g. "192.0.2.0/24".
+func IsCIDR(value string) bool {
+	_, _, err := net.ParseCIDR(value)
+	return err == nil
+}
+
+// IsURI tells whether the value is an absolute URI as parsed by net/url, e.g. "https://example.com/callback". A
+// non-empty list of schemes restricts the scheme, compared case-insensitively. requireHost rejects URIs without a
+// host, and forbidUserinfo rejects URIs with user information.
+func IsURI(value string, schemes []string, requireHost bool, forbidUserinfo bool) bool {
+	u, err := url.Parse(value)
+	return err == nil && u.IsAbs() && isAllowedURI(u, schemes, requireHost, forbidUserinfo)
+}
+
+// IsURIRef tells whether the value is a URI reference as parsed by net/url, either an absolute URI or a relative one
+// such as "/callback". The options are the ones of IsURI, the schemes only restricting absolute URIs.
+func IsURIRef(value string, schemes []string, requireHost bool, forbidUserinfo bool) bool {
+	u, err := url.Parse(value)
+	return err == nil && isAllowedURI(u, schemes, requireHost, forbidUserinfo)
+}
+
+func isAllowedURI(u *url.URL, schemes []string, requireHost bool, forbidUserinfo bool) bool {
+	if requireHost && u.Hostname() == "" {
+		return false
+	}
+	if forbidUserinfo && u.User != nil {
+		return false
+	}
+	if len(schemes) == 0 || u.Scheme == "" {
+		return true
+	}
+	for _, scheme := range schemes {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return true
+		}
+	}
+	return false
+}
@@ -8,5 +8,6 @@ require (
 	github.com/stretchr/testify v1.5.1
 	golang.org/x/text v0.3.5
 	google.golang.org/genproto v0.0.0-20210423144448-3a41ef94ed2b
+	google.golang.org/grpc v1.37.0
 	google.golang.org/protobuf v1.26.0
 )
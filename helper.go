@@ -21,6 +21,23 @@ func CallValidatorIfExists(candidate interface{}) []*errdetails.BadRequest_Field
 	return nil
 }
 
+// FirstValidator is implemented by messages generated with validate_first, returning only their first violation.
+type FirstValidator interface {
+	ValidateFirst() *errdetails.BadRequest_FieldViolation
+}
+
+// CallFirstValidatorIfExists returns the first violation of the candidate, or nil if it is valid or has no validator.
+// Candidates without ValidateFirst are fully validated.
+func CallFirstValidatorIfExists(candidate interface{}) *errdetails.BadRequest_FieldViolation {
+	if validator, ok := candidate.(FirstValidator); ok {
+		return validator.ValidateFirst()
+	}
+	if violations := CallValidatorIfExists(candidate); len(violations) > 0 {
+		return violations[0]
+	}
+	return nil
+}
+
 type fieldError struct {
 	fieldStack []string
 	nestedErr  error
@@ -31,6 +48,8 @@ func (f *fieldError) Error() string {
 }
 
 // FieldError wraps a given Validator error providing a message call stack.
+//
+// Deprecated: generated code reports violations instead of errors, use ValidationError to handle them as an error.
 func FieldError(fieldName string, err error) error {
 	if fErr, ok := err.(*fieldError); ok {
 		fErr.fieldStack = append([]string{fieldName}, fErr.fieldStack...)
@@ -0,0 +1,154 @@
+package validator
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultStatusMessage = "request validation failed"
+
+type interceptorOptions struct {
+	skippedMethods map[string]bool
+	statusMessage  func(fullMethod string, violations []*errdetails.BadRequest_FieldViolation) string
+	logf           func(format string, args ...interface{})
+}
+
+// InterceptorOption customizes the validation interceptors.
+type InterceptorOption func(*interceptorOptions)
+
+// WithSkippedMethods disables validation for the given full method names, e.g. "/package.Service/Method".
+func WithSkippedMethods(fullMethods ...string) InterceptorOption {
+	return func(o *interceptorOptions) {
+		for _, fullMethod := range fullMethods {
+			o.skippedMethods[fullMethod] = true
+		}
+	}
+}
+
+// WithStatusMessage sets the function building the message of the InvalidArgument status.
+func WithStatusMessage(statusMessage func(fullMethod string, violations []*errdetails.BadRequest_FieldViolation) string) InterceptorOption {
+	return func(o *interceptorOptions) {
+		o.statusMessage = statusMessage
+	}
+}
+
+// WithLogOnly makes the interceptors report invalid messages through logf, e.g. log.Printf, and let the call
+// proceed instead of failing it.
+func WithLogOnly(logf func(format string, args ...interface{})) InterceptorOption {
+	return func(o *interceptorOptions) {
+		o.logf = logf
+	}
+}
+
+func evaluateInterceptorOptions(opts []InterceptorOption) *interceptorOptions {
+	o := &interceptorOptions{skippedMethods: map[string]bool{}}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// validate calls CallValidatorIfExists on the message and turns its violations into an InvalidArgument status
+// carrying an errdetails.BadRequest. In log only mode the status is logged and nil is returned.
+func (o *interceptorOptions) validate(fullMethod string, msg interface{}) error {
+	if o.skippedMethods[fullMethod] {
+		return nil
+	}
+	violations := CallValidatorIfExists(msg)
+	if len(violations) == 0 {
+		return nil
+	}
+	message := defaultStatusMessage
+	if o.statusMessage != nil {
+		message = o.statusMessage(fullMethod, violations)
+	}
+	validationErr := &ValidationError{Violations: violations}
+	st := status.New(codes.InvalidArgument, message)
+	if detailed, err := st.WithDetails(validationErr.BadRequest()); err == nil {
+		st = detailed
+	}
+	if o.logf != nil {
+		o.logf("%s: %v", fullMethod, st.Err())
+		return nil
+	}
+	return st.Err()
+}
+
+// UnaryServerInterceptor returns a grpc.UnaryServerInterceptor that validates incoming requests and rejects the
+// invalid ones with an InvalidArgument status carrying the violations as errdetails.BadRequest.
+func UnaryServerInterceptor(opts ...InterceptorOption) grpc.UnaryServerInterceptor {
+	o := evaluateInterceptorOptions(opts)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := o.validate(info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a grpc.StreamServerInterceptor that validates every message received on the
+// stream. An invalid message makes RecvMsg return an InvalidArgument status carrying the violations as
+// errdetails.BadRequest.
+func StreamServerInterceptor(opts ...InterceptorOption) grpc.StreamServerInterceptor {
+	o := evaluateInterceptorOptions(opts)
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: stream, fullMethod: info.FullMethod, options: o})
+	}
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+	fullMethod string
+	options    *interceptorOptions
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.options.validate(s.fullMethod, m)
+}
+
+// UnaryClientInterceptor returns a grpc.UnaryClientInterceptor that validates outgoing requests and fails the
+// invalid ones locally, without reaching the server, with an InvalidArgument status carrying the violations as
+// errdetails.BadRequest.
+func UnaryClientInterceptor(opts ...InterceptorOption) grpc.UnaryClientInterceptor {
+	o := evaluateInterceptorOptions(opts)
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		if err := o.validate(method, req); err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, callOpts...)
+	}
+}
+
+// StreamClientInterceptor returns a grpc.StreamClientInterceptor that validates every message sent on the stream.
+// An invalid message is not sent and makes SendMsg return an InvalidArgument status carrying the violations as
+// errdetails.BadRequest.
+func StreamClientInterceptor(opts ...InterceptorOption) grpc.StreamClientInterceptor {
+	o := evaluateInterceptorOptions(opts)
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, callOpts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, callOpts...)
+		if err != nil {
+			return nil, err
+		}
+		return &validatingClientStream{ClientStream: stream, fullMethod: method, options: o}, nil
+	}
+}
+
+type validatingClientStream struct {
+	grpc.ClientStream
+	fullMethod string
+	options    *interceptorOptions
+}
+
+func (s *validatingClientStream) SendMsg(m interface{}) error {
+	if err := s.options.validate(s.fullMethod, m); err != nil {
+		return err
+	}
+	return s.ClientStream.SendMsg(m)
+}
@@ -0,0 +1,497 @@
+// Package messages holds what the plugin and the dynamic validator share to describe violations the same way: the
+// localized rule messages, the wording of durations and the UUID patterns.
+package messages
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	LangPtBr    = "pt_br"
+	LangDefault = "default"
+)
+
+var IntGt = map[string]string{
+	LangPtBr:    `ser maior que '%d'`,
+	LangDefault: `be greater than '%d'`,
+}
+
+var IntLt = map[string]string{
+	LangPtBr:    `ser menor que '%d'`,
+	LangDefault: `be less than '%d'`,
+}
+
+var IntGte = map[string]string{
+	LangPtBr:    `ser maior ou igual que '%d'`,
+	LangDefault: `be greater or equal than '%d'`,
+}
+
+var IntLte = map[string]string{
+	LangPtBr:    `ser menor ou igual que '%d'`,
+	LangDefault: `be less or equal than '%d'`,
+}
+
+var IntMultipleOf = map[string]string{
+	LangPtBr:    `ser um múltiplo de '%d'`,
+	LangDefault: `be a multiple of '%d'`,
+}
+
+var IsInEnum = map[string]string{
+	LangPtBr:    "ser um válido %s enumerador",
+	LangDefault: "be a valid %s enumerator",
+}
+
+var LengthGt = map[string]string{
+	LangPtBr:    `ter um comprimento maior que '%d'`,
+	LangDefault: `have a length greater than '%d'`,
+}
+
+var LengthLt = map[string]string{
+	LangPtBr:    `ter um comprimento menor que '%d'`,
+	LangDefault: `have a length smaller than '%d'`,
+}
+
+var LengthEq = map[string]string{
+	LangPtBr:    `ter um comprimento igual que '%d'`,
+	LangDefault: `have a length equal than '%d'`,
+}
+
+var RunesMin = map[string]string{
+	LangPtBr:    `ter pelo menos %d caracteres`,
+	LangDefault: `have at least %d characters`,
+}
+
+var RunesMax = map[string]string{
+	LangPtBr:    `ter no máximo %d caracteres`,
+	LangDefault: `have at most %d characters`,
+}
+
+var RunesEq = map[string]string{
+	LangPtBr:    `ter exatamente %d caracteres`,
+	LangDefault: `have exactly %d characters`,
+}
+
+var ValidUTF8 = map[string]string{
+	LangPtBr:    "ser um texto UTF-8 válido",
+	LangDefault: "be valid UTF-8",
+}
+
+var FloatGt = map[string]string{
+	LangPtBr:    `ser estritamente maior que '%.2f'`,
+	LangDefault: `be strictly greater than '%.2f'`,
+}
+
+var FloatGtEpsilon = map[string]string{
+	LangPtBr:    ` com uma tolerância de '%.2f'`,
+	LangDefault: ` with a tolerance of '%.2f'`,
+}
+
+var FloatGte = map[string]string{
+	LangPtBr:    `ser maior ou igual a '%.2f'`,
+	LangDefault: `be greater than or equal to '%.2f'`,
+}
+
+var FloatLt = map[string]string{
+	LangPtBr:    `ser estritamente menor que '%.2f'`,
+	LangDefault: `be strictly lower than '%.2f'`,
+}
+
+var FloatLtEpsilon = map[string]string{
+	LangPtBr:    ` com uma tolerância de '%.2f'`,
+	LangDefault: ` with a tolerance of '%.2f'`,
+}
+
+var FloatLte = map[string]string{
+	LangPtBr:    `ser menor ou igual a '%.2f'`,
+	LangDefault: `be lower than or equal to '%.2f'`,
+}
+
+var FloatFinite = map[string]string{
+	LangPtBr:    "ser um número finito",
+	LangDefault: "be a finite number",
+}
+
+var FloatNotNaN = map[string]string{
+	LangPtBr:    "ser um número",
+	LangDefault: "be a number",
+}
+
+var FloatMultipleOf = map[string]string{
+	LangPtBr:    `ser um múltiplo de '%v'`,
+	LangDefault: `be a multiple of '%v'`,
+}
+
+var FloatMultipleOfEpsilon = map[string]string{
+	LangPtBr:    ` com uma tolerância de '%v'`,
+	LangDefault: ` with a tolerance of '%v'`,
+}
+
+var Regex = map[string]string{
+	LangPtBr:    "estar em conformidade com regex ",
+	LangDefault: "be a string conforming to regex ",
+}
+
+var Email = map[string]string{
+	LangPtBr:    "ser um endereço de e-mail válido",
+	LangDefault: "be a valid email address",
+}
+
+var Hostname = map[string]string{
+	LangPtBr:    "ser um nome de host válido",
+	LangDefault: "be a valid hostname",
+}
+
+var IP = map[string]string{
+	LangPtBr:    "ser um endereço IP válido",
+	LangDefault: "be a valid IP address",
+}
+
+var IPv4 = map[string]string{
+	LangPtBr:    "ser um endereço IPv4 válido",
+	LangDefault: "be a valid IPv4 address",
+}
+
+var IPv6 = map[string]string{
+	LangPtBr:    "ser um endereço IPv6 válido",
+	LangDefault: "be a valid IPv6 address",
+}
+
+var CIDR = map[string]string{
+	LangPtBr:    "ser um bloco CIDR válido",
+	LangDefault: "be a valid CIDR block",
+}
+
+var URI = map[string]string{
+	LangPtBr:    "ser uma URI absoluta válida",
+	LangDefault: "be a valid absolute URI",
+}
+
+var URIRef = map[string]string{
+	LangPtBr:    "ser uma referência de URI válida",
+	LangDefault: "be a valid URI reference",
+}
+
+var URISchemes = map[string]string{
+	LangPtBr:    ` com um esquema entre %s`,
+	LangDefault: ` with a scheme among %s`,
+}
+
+var URIRequireHost = map[string]string{
+	LangPtBr:    ` com um host`,
+	LangDefault: ` with a host`,
+}
+
+var URIForbidUserinfo = map[string]string{
+	LangPtBr:    ` sem informações de usuário`,
+	LangDefault: ` without user information`,
+}
+
+var Prefix = map[string]string{
+	LangPtBr:    `começar com %s`,
+	LangDefault: `start with %s`,
+}
+
+var Suffix = map[string]string{
+	LangPtBr:    `terminar com %s`,
+	LangDefault: `end with %s`,
+}
+
+var Contains = map[string]string{
+	LangPtBr:    `conter %s`,
+	LangDefault: `contain %s`,
+}
+
+var NotContains = map[string]string{
+	LangPtBr:    `não conter %s`,
+	LangDefault: `not contain %s`,
+}
+
+var StringNotEmpty = map[string]string{
+	LangPtBr:    "deve ser preenchido",
+	LangDefault: "must not be an empty string",
+}
+
+var TrimmedStringNotEmpty = map[string]string{
+	LangPtBr:    "deve ser preenchido",
+	LangDefault: "must not be an empty string",
+}
+
+var RepeatedCountMin = map[string]string{
+	LangPtBr:    `conter pelo menos %v elementos`,
+	LangDefault: `contain at least %v elements`,
+}
+
+var RepeatedCountMax = map[string]string{
+	LangPtBr:    `conter no máximo %v elementos`,
+	LangDefault: `contain at most %v elements`,
+}
+
+var MapCountMin = map[string]string{
+	LangPtBr:    `conter pelo menos %v pares`,
+	LangDefault: `contain at least %v pairs`,
+}
+
+var MapCountMax = map[string]string{
+	LangPtBr:    `conter no máximo %v pares`,
+	LangDefault: `contain at most %v pairs`,
+}
+
+var MsgExists = map[string]string{
+	LangPtBr:    `os dados devem ser preenchidos`,
+	LangDefault: `message must exist`,
+}
+
+var MsgExistsIfAnotherNot = map[string]string{
+	LangPtBr:    `os dados devem ser preenchidos se %s estiver vazio`,
+	LangDefault: `message must exist if message %s is not exists`,
+}
+
+var String = map[string]string{
+	LangPtBr:    `valor '%v' deve `,
+	LangDefault: `value '%v' must `,
+}
+
+var OneofValidator = map[string]string{
+	LangPtBr:    "um dos campos deve ser definido",
+	LangDefault: "one of the fields must be set",
+}
+
+var DecimalPlacesLte = map[string]string{
+	LangPtBr:    `ter um número de casas decimais menor ou igual que '%d'`,
+	LangDefault: `have a number of decimal places less or equal than '%d'`,
+}
+
+var In = map[string]string{
+	LangPtBr:    `ser um dos valores %s`,
+	LangDefault: `be one of %s`,
+}
+
+var NotIn = map[string]string{
+	LangPtBr:    `não ser um dos valores %s`,
+	LangDefault: `not be one of %s`,
+}
+
+var Const = map[string]string{
+	LangPtBr:    `ser igual a %s`,
+	LangDefault: `be equal to %s`,
+}
+
+var DecimalPlacesGte = map[string]string{
+	LangPtBr:    `ter um número de casas decimais maior ou igual que '%d'`,
+	LangDefault: `have a number of decimal places greater or equal than '%d'`,
+}
+
+var SignificantDigitsLte = map[string]string{
+	LangPtBr:    `ter no máximo '%d' dígitos significativos`,
+	LangDefault: `have at most '%d' significant digits`,
+}
+
+var TimestampValid = map[string]string{
+	LangPtBr:    "ser um timestamp válido",
+	LangDefault: "be a valid timestamp",
+}
+
+var TimestampLt = map[string]string{
+	LangPtBr:    `ser anterior a '%s'`,
+	LangDefault: `be before '%s'`,
+}
+
+var TimestampGt = map[string]string{
+	LangPtBr:    `ser posterior a '%s'`,
+	LangDefault: `be after '%s'`,
+}
+
+var TimestampLtNow = map[string]string{
+	LangPtBr:    "estar no passado",
+	LangDefault: "be in the past",
+}
+
+var TimestampGtNow = map[string]string{
+	LangPtBr:    "estar no futuro",
+	LangDefault: "be in the future",
+}
+
+var TimestampWithin = map[string]string{
+	LangPtBr:    `estar a no máximo '%s' do momento atual`,
+	LangDefault: `be within '%s' of now`,
+}
+
+var DurationLt = map[string]string{
+	LangPtBr:    `ser menor que '%s'`,
+	LangDefault: `be shorter than '%s'`,
+}
+
+var DurationLte = map[string]string{
+	LangPtBr:    `ser no máximo '%s'`,
+	LangDefault: `be at most '%s'`,
+}
+
+var DurationGt = map[string]string{
+	LangPtBr:    `ser maior que '%s'`,
+	LangDefault: `be longer than '%s'`,
+}
+
+var DurationGte = map[string]string{
+	LangPtBr:    `ser no mínimo '%s'`,
+	LangDefault: `be at least '%s'`,
+}
+
+var byRule = map[string]map[string]string{
+	"int_gt":                    IntGt,
+	"int_lt":                    IntLt,
+	"int_gte":                   IntGte,
+	"int_lte":                   IntLte,
+	"uint_gt":                   IntGt,
+	"uint_lt":                   IntLt,
+	"uint_gte":                  IntGte,
+	"uint_lte":                  IntLte,
+	"int_multiple_of":           IntMultipleOf,
+	"is_in_enum":                IsInEnum,
+	"length_gt":                 LengthGt,
+	"length_lt":                 LengthLt,
+	"length_eq":                 LengthEq,
+	"runes_min":                 RunesMin,
+	"runes_max":                 RunesMax,
+	"runes_eq":                  RunesEq,
+	"valid_utf8":                ValidUTF8,
+	"float_gt":                  FloatGt,
+	"float_gt_epsilon":          FloatGtEpsilon,
+	"float_gte":                 FloatGte,
+	"float_lt":                  FloatLt,
+	"float_lt_epsilon":          FloatLtEpsilon,
+	"float_lte":                 FloatLte,
+	"float_finite":              FloatFinite,
+	"float_not_nan":             FloatNotNaN,
+	"float_multiple_of":         FloatMultipleOf,
+	"float_multiple_of_epsilon": FloatMultipleOfEpsilon,
+	"regex":                     Regex,
+	"email":                     Email,
+	"hostname":                  Hostname,
+	"ip":                        IP,
+	"ipv4":                      IPv4,
+	"ipv6":                      IPv6,
+	"cidr":                      CIDR,
+	"uri":                       URI,
+	"uri_ref":                   URIRef,
+	"uri_schemes":               URISchemes,
+	"uri_require_host":          URIRequireHost,
+	"uri_forbid_userinfo":       URIForbidUserinfo,
+	"prefix":                    Prefix,
+	"suffix":                    Suffix,
+	"contains":                  Contains,
+	"not_contains":              NotContains,
+	"string_not_empty":          StringNotEmpty,
+	"trimmed_string_not_empty":  TrimmedStringNotEmpty,
+	"repeated_count_min":        RepeatedCountMin,
+	"repeated_count_max":        RepeatedCountMax,
+	"map_count_min":             MapCountMin,
+	"map_count_max":             MapCountMax,
+	"msg_exists":                MsgExists,
+	"msg_exists_if_another_not": MsgExistsIfAnotherNot,
+	"value":                     String,
+	"oneof_required":            OneofValidator,
+	"decimal_places_lte":        DecimalPlacesLte,
+	"decimal_places_gte":        DecimalPlacesGte,
+	"significant_digits_lte":    SignificantDigitsLte,
+	"string_in":                 In,
+	"string_not_in":             NotIn,
+	"int_in":                    In,
+	"int_not_in":                NotIn,
+	"bool_const":                Const,
+	"string_const":              Const,
+	"int_const":                 Const,
+	"float_const":               Const,
+	"enum_const":                Const,
+	"timestamp_valid":           TimestampValid,
+	"timestamp_lt":              TimestampLt,
+	"timestamp_gt":              TimestampGt,
+	"timestamp_lt_now":          TimestampLtNow,
+	"timestamp_gt_now":          TimestampGtNow,
+	"timestamp_within":          TimestampWithin,
+	"duration_lt":               DurationLt,
+	"duration_lte":              DurationLte,
+	"duration_gt":               DurationGt,
+	"duration_gte":              DurationGte,
+	"duration_in":               In,
+}
+
+// ForRule returns the localized text that the generated code uses to describe a violation of the given rule,
+// e.g. ForRule("int_gt", LangDefault) returns "be greater than '%d'". The "value" rule is the prefix of the
+// descriptions that quote the field value.
+func ForRule(rule string, language string) string {
+	return byRule[rule][language]
+}
+
+// durationUnits are the units durations are described with, from the longest, with their singular and plural names.
+var durationUnits = []struct {
+	length   time.Duration
+	singular map[string]string
+	plural   map[string]string
+}{
+	{24 * time.Hour, map[string]string{LangPtBr: "dia", LangDefault: "day"}, map[string]string{LangPtBr: "dias", LangDefault: "days"}},
+	{time.Hour, map[string]string{LangPtBr: "hora", LangDefault: "hour"}, map[string]string{LangPtBr: "horas", LangDefault: "hours"}},
+	{time.Minute, map[string]string{LangPtBr: "minuto", LangDefault: "minute"}, map[string]string{LangPtBr: "minutos", LangDefault: "minutes"}},
+	{time.Second, map[string]string{LangPtBr: "segundo", LangDefault: "second"}, map[string]string{LangPtBr: "segundos", LangDefault: "seconds"}},
+	{time.Millisecond, map[string]string{LangPtBr: "milissegundo", LangDefault: "millisecond"}, map[string]string{LangPtBr: "milissegundos", LangDefault: "milliseconds"}},
+	{time.Microsecond, map[string]string{LangPtBr: "microssegundo", LangDefault: "microsecond"}, map[string]string{LangPtBr: "microssegundos", LangDefault: "microseconds"}},
+	{time.Nanosecond, map[string]string{LangPtBr: "nanossegundo", LangDefault: "nanosecond"}, map[string]string{LangPtBr: "nanossegundos", LangDefault: "nanoseconds"}},
+}
+
+var durationZero = map[string]string{
+	LangPtBr:    "0 segundos",
+	LangDefault: "0 seconds",
+}
+
+var durationAnd = map[string]string{
+	LangPtBr:    " e ",
+	LangDefault: " and ",
+}
+
+// FormatDuration describes the duration in words the way the duration rules are described, e.g.
+// FormatDuration(90*time.Minute, LangDefault) returns "1 hour and 30 minutes".
+func FormatDuration(d time.Duration, language string) string {
+	if d == 0 {
+		return durationZero[language]
+	}
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	var parts []string
+	for _, unit := range durationUnits {
+		count := d / unit.length
+		if count == 0 {
+			continue
+		}
+		name := unit.plural[language]
+		if count == 1 {
+			name = unit.singular[language]
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", count, name))
+		d -= count * unit.length
+	}
+	if len(parts) == 1 {
+		return sign + parts[0]
+	}
+	return sign + strings.Join(parts[:len(parts)-1], ", ") + durationAnd[language] + parts[len(parts)-1]
+}
+
+const uuidPattern = "^([a-fA-F0-9]{8}-" +
+	"[a-fA-F0-9]{4}-" +
+	"[%s][a-fA-F0-9]{3}-" +
+	"[8|9|aA|bB][a-fA-F0-9]{3}-" +
+	"[a-fA-F0-9]{12})?$"
+
+// UUIDRegex returns a regex to validate that a string is in UUID format, as the uuid_ver rule does. The version
+// parameter specifies the UUID version. If version is 0, the returned regex is valid for any UUID version.
+func UUIDRegex(version int32) (string, error) {
+	if version < 0 || version > 5 {
+		return "", fmt.Errorf("UUID version should be between 0-5, Got %d", version)
+	} else if version == 0 {
+		return fmt.Sprintf(uuidPattern, "1-5"), nil
+	}
+	return fmt.Sprintf(uuidPattern, strconv.Itoa(int(version))), nil
+}
@@ -0,0 +1,45 @@
+package validator
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// IsMultipleOf tells whether the value is a multiple of the step, within the tolerance. The rounding error of a value
+// stored with the given bitSize, 32 for float and 64 for double, is tolerated on top of it, so that 0.15 is a
+// multiple of 0.05.
+func IsMultipleOf(value float64, step float64, tolerance float64, bitSize int) bool {
+	if step == 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return false
+	}
+	precision := 1e-15
+	if bitSize == 32 {
+		precision = 1e-6
+	}
+	distance := math.Abs(value - math.Round(value/step)*step)
+	return distance <= tolerance+precision*math.Max(math.Abs(value), math.Abs(step))
+}
+
+// DecimalPlaces returns the number of decimal places of the shortest decimal representation of a value stored with
+// the given bitSize, 32 for float and 64 for double, e.g. 2 for 0.15 even as a float and 7 for 1e-07. It returns 0
+// for NaN and infinite values.
+func DecimalPlaces(value float64, bitSize int) int {
+	formatted := strconv.FormatFloat(value, 'f', -1, bitSize)
+	if dot := strings.IndexByte(formatted, '.'); dot >= 0 {
+		return len(formatted) - dot - 1
+	}
+	return 0
+}
+
+// SignificantDigits returns the number of digits of the shortest decimal representation of a value stored with the
+// given bitSize, leading and trailing zeros excluded, e.g. 5 for 123.45, 1 for 0.05 and 2 for 1200. It returns 0 for
+// NaN and infinite values.
+func SignificantDigits(value float64, bitSize int) int {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0
+	}
+	mantissa := strconv.FormatFloat(math.Abs(value), 'e', -1, bitSize)
+	mantissa = mantissa[:strings.IndexByte(mantissa, 'e')]
+	return len(strings.Replace(mantissa, ".", "", 1))
+}
@@ -0,0 +1,49 @@
+// Copyright 2016 Michal Witkowski. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package plugin
+
+import (
+	"flag"
+	"go/format"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the testdata/golden*.validator.pb.go files with the generated code")
+
+// TestGolden generates testdata/golden.proto from its FileDescriptorSet, testdata/golden.pb, and compares the code
+// with testdata/golden.validator.pb.go, or testdata/golden_gogo.validator.pb.go with gogoimport=true. Run
+// `make regenerate_golden` after changing the generated code on purpose.
+func TestGolden(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/golden.pb")
+	require.NoError(t, err)
+	set := &descriptor.FileDescriptorSet{}
+	require.NoError(t, proto.Unmarshal(data, set))
+
+	for golden, useGogoImport := range map[string]bool{
+		"testdata/golden.validator.pb.go":      false,
+		"testdata/golden_gogo.validator.pb.go": true,
+	} {
+		t.Run(golden, func(t *testing.T) {
+			_, response := generateImport(t, true, useGogoImport, []string{"golden.proto"}, set.File...)
+			require.Nil(t, response.Error, response.GetError())
+			require.Len(t, response.File, 1)
+			// gofmt sorts the imports, which the generator writes in no particular order.
+			generated, err := format.Source([]byte(response.File[0].GetContent()))
+			require.NoError(t, err)
+
+			if *update {
+				require.NoError(t, ioutil.WriteFile(golden, generated, 0644))
+			}
+			expected, err := ioutil.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(generated), "the generated code differs from %s", golden)
+		})
+	}
+}
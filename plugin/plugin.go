@@ -50,10 +50,12 @@ package plugin
 
 import (
 	"fmt"
-	"os"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/gogo/protobuf/gogoproto"
 	"github.com/gogo/protobuf/proto"
@@ -62,13 +64,13 @@ import (
 	"github.com/gogo/protobuf/vanity"
 
 	validator "github.com/lucianoapolo/go-proto-validators"
+	"github.com/lucianoapolo/go-proto-validators/internal/messages"
 )
 
-const uuidPattern = "^([a-fA-F0-9]{8}-" +
-	"[a-fA-F0-9]{4}-" +
-	"[%s][a-fA-F0-9]{3}-" +
-	"[8|9|aA|bB][a-fA-F0-9]{3}-" +
-	"[a-fA-F0-9]{12})?$"
+const (
+	LangPtBr    = messages.LangPtBr
+	LangDefault = messages.LangDefault
+)
 
 type plugin struct {
 	*generator.Generator
@@ -76,9 +78,26 @@ type plugin struct {
 	regexPkg      generator.Single
 	fmtPkg        generator.Single
 	stringsPkg    generator.Single
+	bytesPkg      generator.Single
+	utf8Pkg       generator.Single
+	mathPkg       generator.Single
 	validatorPkg  generator.Single
 	errdetailsPkg generator.Single
 	useGogoImport bool
+	// fieldPath, when set, is the Go expression used as the Field of the
+	// generated violations instead of the quoted field name.
+	fieldPath string
+	// pathName, when set, is the name of the field being generated as it
+	// appears in the Field of the violations, following the field_names parameter.
+	pathName string
+	// fileName is the .proto file being generated, used to locate problems.
+	fileName string
+	// problems are the rule problems found so far, reported as the generation error in strict mode.
+	problems []string
+	// failures are the rule problems that make the generated code invalid, always reported as the generation error.
+	failures []string
+	// failFast, when set, generates ValidateFirst, returning the first violation instead of collecting them all.
+	failFast bool
 }
 
 var lang string
@@ -94,6 +113,48 @@ func SetLanguage(langParam string) {
 	}
 }
 
+const (
+	FieldNamesGo    = "go"
+	FieldNamesProto = "proto"
+	FieldNamesJSON  = "json"
+)
+
+var fieldNames = FieldNamesGo
+
+// SetFieldNames selects how fields are named in the Field of the generated violations.
+func SetFieldNames(fieldNamesParam string) {
+	switch strings.ToLower(fieldNamesParam) {
+	case FieldNamesProto:
+		fieldNames = FieldNamesProto
+	case FieldNamesJSON:
+		fieldNames = FieldNamesJSON
+	default:
+		fieldNames = FieldNamesGo
+	}
+}
+
+var strict bool
+
+// SetStrict makes invalid or contradictory rules fail the generation instead of printing warnings.
+func SetStrict(strictParam bool) {
+	strict = strictParam
+}
+
+var validateErr bool
+
+// SetValidateErr makes the plugin also generate a ValidateErr method returning the violations as a
+// ValidationError.
+func SetValidateErr(validateErrParam bool) {
+	validateErr = validateErrParam
+}
+
+var validateFirst bool
+
+// SetValidateFirst makes the plugin also generate a ValidateFirst method returning on the first violation.
+func SetValidateFirst(validateFirstParam bool) {
+	validateFirst = validateFirstParam
+}
+
 func NewPlugin(useGogoImport bool) generator.Plugin {
 	return &plugin{useGogoImport: useGogoImport}
 }
@@ -114,20 +175,50 @@ func (p *plugin) Generate(file *generator.FileDescriptor) {
 	p.regexPkg = p.NewImport("regexp")
 	p.fmtPkg = p.NewImport("fmt")
 	p.stringsPkg = p.NewImport("strings")
+	p.bytesPkg = p.NewImport("bytes")
+	p.utf8Pkg = p.NewImport("unicode/utf8")
+	p.mathPkg = p.NewImport("math")
 	p.validatorPkg = p.NewImport("github.com/lucianoapolo/go-proto-validators")
 	p.errdetailsPkg = p.NewImport("google.golang.org/genproto/googleapis/rpc/errdetails")
 
+	p.fileName = file.GetName()
+	// The generator runs for the imported files as well, whose output is discarded.
+	if !p.isFileToGenerate(file) {
+		return
+	}
 	for _, msg := range file.Messages() {
 		if msg.DescriptorProto.GetOptions().GetMapEntry() {
 			continue
 		}
+		p.checkMessage(file, msg)
 		p.generateRegexVars(file, msg)
-		if gogoproto.IsProto3(file.FileDescriptorProto) {
-			p.generateProto3Message(file, msg)
-		} else {
-			p.generateProto2Message(file, msg)
+		p.generateMessage(file, msg)
+		if validateFirst {
+			p.failFast = true
+			p.generateMessage(file, msg)
+			p.failFast = false
+		}
+		if validateErr {
+			p.generateValidateErr(msg)
 		}
 	}
+	problems := append([]string{}, p.failures...)
+	if strict {
+		problems = append(problems, p.problems...)
+	}
+	if len(problems) > 0 {
+		p.Response.Error = proto.String(strings.Join(problems, "\n"))
+	}
+}
+
+// isFileToGenerate tells whether protoc asked for the code of the file, rather than it being only imported.
+func (p *plugin) isFileToGenerate(file *generator.FileDescriptor) bool {
+	for _, name := range p.Request.FileToGenerate {
+		if name == file.GetName() {
+			return true
+		}
+	}
+	return false
 }
 
 func getFieldValidatorIfAny(field *descriptor.FieldDescriptorProto) []*validator.FieldValidator {
@@ -158,17 +249,68 @@ func (p *plugin) isSupportedInt(field *descriptor.FieldDescriptorProto) bool {
 		return true
 	case descriptor.FieldDescriptorProto_TYPE_SINT32, descriptor.FieldDescriptorProto_TYPE_SINT64:
 		return true
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return true
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return true
 	}
 	return false
 }
 
-func (p *plugin) isSupportedFloat(field *descriptor.FieldDescriptorProto) bool {
+func (p *plugin) isUnsignedInt(field *descriptor.FieldDescriptorProto) bool {
 	switch *(field.Type) {
-	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_UINT64:
 		return true
 	case descriptor.FieldDescriptorProto_TYPE_FIXED32, descriptor.FieldDescriptorProto_TYPE_FIXED64:
 		return true
-	case descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+	}
+	return false
+}
+
+// wrapperTypes are the types of the Value of the google.protobuf wrapper types, e.g. google.protobuf.Int64Value.
+var wrapperTypes = map[string]descriptor.FieldDescriptorProto_Type{
+	".google.protobuf.DoubleValue": descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+	".google.protobuf.FloatValue":  descriptor.FieldDescriptorProto_TYPE_FLOAT,
+	".google.protobuf.Int64Value":  descriptor.FieldDescriptorProto_TYPE_INT64,
+	".google.protobuf.UInt64Value": descriptor.FieldDescriptorProto_TYPE_UINT64,
+	".google.protobuf.Int32Value":  descriptor.FieldDescriptorProto_TYPE_INT32,
+	".google.protobuf.UInt32Value": descriptor.FieldDescriptorProto_TYPE_UINT32,
+	".google.protobuf.BoolValue":   descriptor.FieldDescriptorProto_TYPE_BOOL,
+	".google.protobuf.StringValue": descriptor.FieldDescriptorProto_TYPE_STRING,
+	".google.protobuf.BytesValue":  descriptor.FieldDescriptorProto_TYPE_BYTES,
+}
+
+// wrapperValueField returns the Value field of a google.protobuf wrapper type field, the scalar rules of the field
+// applying to it, or nil if the field is not of a wrapper type.
+func (p *plugin) wrapperValueField(field *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	valueType, ok := wrapperTypes[field.GetTypeName()]
+	if !ok || gogoproto.IsStdType(field) {
+		return nil
+	}
+	return &descriptor.FieldDescriptorProto{
+		Name:     proto.String("value"),
+		Number:   proto.Int32(1),
+		Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     valueType.Enum(),
+		JsonName: proto.String("value"),
+	}
+}
+
+// isTimestamp tells whether the field is a google.protobuf.Timestamp with its Seconds and Nanos, i.e. not one of
+// gogoproto.stdtime.
+func (p *plugin) isTimestamp(field *descriptor.FieldDescriptorProto) bool {
+	return field.GetTypeName() == ".google.protobuf.Timestamp" && !gogoproto.IsStdTime(field)
+}
+
+// isDuration tells whether the field is a google.protobuf.Duration with its Seconds and Nanos, i.e. not one of
+// gogoproto.stdduration.
+func (p *plugin) isDuration(field *descriptor.FieldDescriptorProto) bool {
+	return field.GetTypeName() == ".google.protobuf.Duration" && !gogoproto.IsStdDuration(field)
+}
+
+func (p *plugin) isSupportedFloat(field *descriptor.FieldDescriptorProto) bool {
+	switch *(field.Type) {
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_DOUBLE:
 		return true
 	}
 	return false
@@ -181,24 +323,34 @@ func (p *plugin) generateRegexVars(file *generator.FileDescriptor, message *gene
 		if len(validators) > 0 {
 			for i, validator := range validators {
 				fieldName := p.GetOneOfFieldName(message, field)
-				if validator.Regex != nil && validator.UuidVer != nil {
-					fmt.Fprintf(os.Stderr, "WARNING: regex and uuid validator is set for field %v.%v, only one of them can be set. Regex and UUID validator is ignored for this field.", ccTypeName, fieldName)
-				} else if validator.UuidVer != nil {
-					uuid, err := getUUIDRegex(validator.UuidVer)
-					if err != nil {
-						fmt.Fprintf(os.Stderr, "WARNING: field %v.%v error %s.\n", ccTypeName, fieldName, err)
-					} else {
-						validator.Regex = &uuid
-						p.P(`var `, p.regexName(ccTypeName, fieldName, i), ` = `, p.regexPkg.Use(), `.MustCompile(`, "`", *validator.Regex, "`", `)`)
-					}
-				} else if validator.Regex != nil {
-					p.P(`var `, p.regexName(ccTypeName, fieldName, i), ` = `, p.regexPkg.Use(), `.MustCompile(`, "`", *validator.Regex, "`", `)`)
+				p.generateRegexVar(ccTypeName, fieldName, i, validator)
+				if validator.MapKey != nil {
+					p.generateRegexVar(ccTypeName, fieldName+"_key", i, validator.MapKey)
+				}
+				if validator.MapValue != nil {
+					p.generateRegexVar(ccTypeName, fieldName+"_value", i, validator.MapValue)
 				}
 			}
 		}
 	}
 }
 
+func (p *plugin) generateRegexVar(ccTypeName string, fieldName string, index int, validator *validator.FieldValidator) {
+	if validator.Regex != nil && validator.UuidVer != nil {
+		p.warnf(ccTypeName, fieldName, "has both regex and uuid validators set, only one of them can be set. Regex and UUID validator is ignored for this field")
+	} else if validator.UuidVer != nil {
+		uuid, err := getUUIDRegex(validator.UuidVer)
+		if err != nil {
+			p.warnf(ccTypeName, fieldName, "error %s", err)
+		} else {
+			validator.Regex = &uuid
+			p.P(`var `, p.regexName(ccTypeName, fieldName, index), ` = `, p.regexPkg.Use(), `.MustCompile(`, "`", *validator.Regex, "`", `)`)
+		}
+	} else if validator.Regex != nil {
+		p.P(`var `, p.regexName(ccTypeName, fieldName, index), ` = `, p.regexPkg.Use(), `.MustCompile(`, "`", *validator.Regex, "`", `)`)
+	}
+}
+
 func (p *plugin) GetFieldName(message *generator.Descriptor, field *descriptor.FieldDescriptorProto) string {
 	fieldName := p.Generator.GetFieldName(message, field)
 	if p.useGogoImport {
@@ -221,15 +373,25 @@ func (p *plugin) GetOneOfFieldName(message *generator.Descriptor, field *descrip
 	return fieldName
 }
 
+func (p *plugin) generateMessage(file *generator.FileDescriptor, message *generator.Descriptor) {
+	if gogoproto.IsProto3(file.FileDescriptorProto) {
+		p.generateProto3Message(file, message)
+	} else {
+		p.generateProto2Message(file, message)
+	}
+}
+
 func (p *plugin) generateProto2Message(file *generator.FileDescriptor, message *generator.Descriptor) {
 	ccTypeName := generator.CamelCaseSlice(message.TypeName())
 
-	p.P(`func (this *`, ccTypeName, `) Validate() []*`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation {`)
+	p.generateValidateSignature(ccTypeName)
 	p.In()
 
 	if fieldValidatorExists(message) {
 
-		p.P(`fieldsViolations := []*`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{}`)
+		if !p.failFast {
+			p.P(`fieldsViolations := []*`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{}`)
+		}
 
 		for _, field := range message.Field {
 			fieldName := p.GetFieldName(message, field)
@@ -237,10 +399,17 @@ func (p *plugin) generateProto2Message(file *generator.FileDescriptor, message *
 			if len(validators) == 0 && !field.IsMessage() {
 				continue
 			}
+			pathName := p.fieldPathName(field, fieldName)
+			p.pathName = pathName
 			if p.validatorWithMessageExists(validators) {
-				fmt.Fprintf(os.Stderr, "WARNING: field %v.%v is a proto2 message, validator.msg_exists has no effect\n", ccTypeName, fieldName)
+				p.warnf(ccTypeName, fieldName, "is a proto2 message, validator.msg_exists has no effect")
 			}
 			variableName := "this." + fieldName
+			// Maps are repeated map entry messages in proto2 as well
+			if p.fieldIsProto3Map(file, message, field) {
+				p.generateMapValidator(file, message, field, variableName, ccTypeName, fieldName, validators)
+				continue
+			}
 			repeated := field.IsRepeated()
 			nullable := gogoproto.IsNullable(field) && !(p.useGogoImport && gogoproto.IsEmbed(field))
 			// For proto2 syntax, only Gogo generates non-pointer fields
@@ -248,14 +417,16 @@ func (p *plugin) generateProto2Message(file *generator.FileDescriptor, message *
 			if repeated {
 				p.generateRepeatedCountValidator(variableName, ccTypeName, fieldName, validators)
 				if field.IsMessage() || p.validatorWithNonRepeatedConstraint(validators) {
-					p.P(`for _, item := range `, variableName, `{`)
+					p.P(`for i, item := range `, variableName, `{`)
 					p.In()
 					variableName = "item"
+					p.fieldPath = p.fmtPkg.Use() + `.Sprintf("` + pathName + `[%d]", i)`
 				}
 			} else if nullable {
 				p.P(`if `, variableName, ` != nil {`)
 				p.In()
-				if !field.IsBytes() {
+				// bytes and messages, such as the well-known types, are validated through their pointer
+				if !field.IsBytes() && !field.IsMessage() {
 					variableName = "*(" + variableName + ")"
 				}
 			} else if nonpointer {
@@ -266,35 +437,39 @@ func (p *plugin) generateProto2Message(file *generator.FileDescriptor, message *
 			if !repeated && len(validators) > 0 {
 				for _, validator := range validators {
 					if validator.RepeatedCountMin != nil {
-						fmt.Fprintf(os.Stderr, "WARNING: field %v.%v is not repeated, validator.min_elts has no effects\n", ccTypeName, fieldName)
+						p.warnf(ccTypeName, fieldName, "is not repeated, validator.min_elts has no effects")
 					}
 					if validator.RepeatedCountMax != nil {
-						fmt.Fprintf(os.Stderr, "WARNING: field %v.%v is not repeated, validator.max_elts has no effects\n", ccTypeName, fieldName)
+						p.warnf(ccTypeName, fieldName, "is not repeated, validator.max_elts has no effects")
 					}
 				}
 			}
+			fieldVariableName := variableName
+			if field.IsMessage() && !nullable {
+				fieldVariableName = "&(" + variableName + ")"
+			}
 			for i, validator := range validators {
-				if field.IsString() {
-					p.generateStringValidator(variableName, ccTypeName, fieldName, validator, i)
-				} else if p.isSupportedInt(field) {
-					p.generateIntValidator(variableName, ccTypeName, fieldName, validator)
-				} else if field.IsEnum() {
-					p.generateEnumValidator(field, variableName, ccTypeName, fieldName, validator)
-				} else if p.isSupportedFloat(field) {
-					p.generateFloatValidator(variableName, ccTypeName, fieldName, validator, i)
-				} else if field.IsBytes() {
-					p.generateLengthValidator(variableName, ccTypeName, fieldName, validator)
-				}
+				p.generateFieldValidator(field, fieldVariableName, ccTypeName, fieldName, validator, i)
 			}
 			if field.IsMessage() {
-				if repeated && nullable {
-					variableName = "*(item)"
+				// nested messages must be passed as pointers to reach their Validate method
+				if repeated {
+					variableName = "item"
+				} else {
+					variableName = "this." + fieldName
+				}
+				if nonpointer {
+					variableName = "&(" + variableName + ")"
+				} else if !nullable && !repeated {
+					// Golang's proto2 keeps non-nullable messages as pointers, which may be unset
+					p.P(`if `, variableName, ` != nil {`)
+					p.In()
+				}
+				p.generateNestedValidator(variableName, pathName)
+				if !nonpointer && !nullable && !repeated {
+					p.Out()
+					p.P(`}`)
 				}
-				p.P(`if fieldsViolationsChild := `, p.validatorPkg.Use(), `.CallValidatorIfExists(`, variableName, `); fieldsViolationsChild != nil {`)
-				p.In()
-				p.P(`fieldsViolations = append(fieldsViolations, fieldsViolationsChild...)`)
-				p.Out()
-				p.P(`}`)
 			}
 			if repeated {
 				// end the repeated loop
@@ -302,6 +477,7 @@ func (p *plugin) generateProto2Message(file *generator.FileDescriptor, message *
 					// This internal 'if' cannot be refactored as it would change semantics with respect to the corresponding prelude 'if's
 					p.Out()
 					p.P(`}`)
+					p.fieldPath = ""
 				}
 			} else if nullable {
 				// end the if around nullable
@@ -310,15 +486,8 @@ func (p *plugin) generateProto2Message(file *generator.FileDescriptor, message *
 
 			}
 		}
-		p.P(`if len(fieldsViolations) > 0 {`)
-		p.In()
-		p.P(`return fieldsViolations`)
-		p.Out()
-		p.P(`} else {`)
-		p.In()
-		p.P(`return nil`)
-		p.Out()
-		p.P(`}`)
+		p.pathName = ""
+		p.generateViolationsReturn()
 
 	} else {
 		p.P(`return nil`)
@@ -331,12 +500,14 @@ func (p *plugin) generateProto2Message(file *generator.FileDescriptor, message *
 func (p *plugin) generateProto3Message(file *generator.FileDescriptor, message *generator.Descriptor) {
 	ccTypeName := generator.CamelCaseSlice(message.TypeName())
 
-	p.P(`func (this *`, ccTypeName, `) Validate() []*`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation {`)
+	p.generateValidateSignature(ccTypeName)
 	p.In()
 
 	if fieldValidatorExists(message) {
 
-		p.P(`fieldsViolations := []*`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{}`)
+		if !p.failFast {
+			p.P(`fieldsViolations := []*`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{}`)
+		}
 
 		for _, oneof := range message.OneofDecl {
 			oneofValidator := getOneofValidatorIfAny(oneof)
@@ -347,8 +518,8 @@ func (p *plugin) generateProto3Message(file *generator.FileDescriptor, message *
 				oneOfName := generator.CamelCase(oneof.GetName())
 				p.P(`if this.Get` + oneOfName + `() == nil {`)
 				p.In()
-				p.P(`fieldViolation := &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: "`, oneOfName, `", Description: "`, errorOneofValidator[lang], `"}`)
-				p.P(`fieldsViolations = append(fieldsViolations, fieldViolation)`)
+				p.P(`fieldViolation := &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: "`, p.oneofPathName(oneof), `", Description: "`, messages.OneofValidator[lang], `"}`)
+				p.generateAppendViolation()
 				p.Out()
 				p.P(`}`)
 			}
@@ -360,12 +531,14 @@ func (p *plugin) generateProto3Message(file *generator.FileDescriptor, message *
 			}
 			isOneOf := field.OneofIndex != nil
 			fieldName := p.GetOneOfFieldName(message, field)
+			pathName := p.fieldPathName(field, fieldName)
+			p.pathName = pathName
 			variableName := "this." + fieldName
 			repeated := field.IsRepeated()
 			// Golang's proto3 has no concept of unset primitive fields
 			nullable := (gogoproto.IsNullable(field) || !gogoproto.ImportsGoGoProto(file.FileDescriptorProto)) && field.IsMessage() && !(p.useGogoImport && gogoproto.IsEmbed(field))
 			if p.fieldIsProto3Map(file, message, field) {
-				p.P(`// Validation of proto3 map<> fields is unsupported.`)
+				p.generateMapValidator(file, message, field, variableName, ccTypeName, fieldName, validators)
 				continue
 			}
 			if isOneOf {
@@ -379,32 +552,26 @@ func (p *plugin) generateProto3Message(file *generator.FileDescriptor, message *
 			if repeated {
 				p.generateRepeatedCountValidator(variableName, ccTypeName, fieldName, validators)
 				if field.IsMessage() || p.validatorWithNonRepeatedConstraint(validators) {
-					p.P(`for _, item := range `, variableName, `{`)
+					p.P(`for i, item := range `, variableName, `{`)
 					p.In()
 					variableName = "item"
+					p.fieldPath = p.fmtPkg.Use() + `.Sprintf("` + pathName + `[%d]", i)`
 				}
 			} else if len(validators) > 0 {
 				for _, validator := range validators {
 					if validator.RepeatedCountMin != nil {
-						fmt.Fprintf(os.Stderr, "WARNING: field %v.%v is not repeated, validator.min_elts has no effects\n", ccTypeName, fieldName)
+						p.warnf(ccTypeName, fieldName, "is not repeated, validator.min_elts has no effects")
 					}
 					if validator.RepeatedCountMax != nil {
-						fmt.Fprintf(os.Stderr, "WARNING: field %v.%v is not repeated, validator.max_elts has no effects\n", ccTypeName, fieldName)
+						p.warnf(ccTypeName, fieldName, "is not repeated, validator.max_elts has no effects")
 					}
 				}
 			}
+			if p.validatorWithMapConstraint(validators) {
+				p.warnf(ccTypeName, fieldName, "is not a map, validator.map_* has no effects")
+			}
 			for i, validator := range validators {
-				if field.IsString() {
-					p.generateStringValidator(variableName, ccTypeName, fieldName, validator, i)
-				} else if p.isSupportedInt(field) {
-					p.generateIntValidator(variableName, ccTypeName, fieldName, validator)
-				} else if field.IsEnum() {
-					p.generateEnumValidator(field, variableName, ccTypeName, fieldName, validator)
-				} else if p.isSupportedFloat(field) {
-					p.generateFloatValidator(variableName, ccTypeName, fieldName, validator, i)
-				} else if field.IsBytes() {
-					p.generateLengthValidator(variableName, ccTypeName, fieldName, validator)
-				}
+				p.generateFieldValidator(field, variableName, ccTypeName, fieldName, validator, i)
 			}
 			if field.IsMessage() {
 				for _, validator := range validators {
@@ -412,14 +579,14 @@ func (p *plugin) generateProto3Message(file *generator.FileDescriptor, message *
 						if nullable && !repeated {
 							p.P(`if nil == `, variableName, `{`)
 							p.In()
-							errorStr := errorMsgExists[lang]
+							errorStr := messages.MsgExists[lang]
 							p.generateErrorStringEmpty(variableName, fieldName, errorStr, validator)
 							p.Out()
 							p.P(`}`)
 						} else if repeated {
-							fmt.Fprintf(os.Stderr, "WARNING: field %v.%v is repeated, validator.msg_exists has no effect\n", ccTypeName, fieldName)
+							p.warnf(ccTypeName, fieldName, "is repeated, validator.msg_exists has no effect")
 						} else if !nullable {
-							fmt.Fprintf(os.Stderr, "WARNING: field %v.%v is a nullable=false, validator.msg_exists has no effect\n", ccTypeName, fieldName)
+							p.warnf(ccTypeName, fieldName, "is a nullable=false, validator.msg_exists has no effect")
 						}
 					}
 					if validator.MsgExistsIfAnotherNot != nil && *validator.MsgExistsIfAnotherNot != "" {
@@ -428,14 +595,14 @@ func (p *plugin) generateProto3Message(file *generator.FileDescriptor, message *
 							anotherVariableName := "this." + anotherFiledName
 							p.P(`if nil == `, variableName, ` && nil == `, anotherVariableName, ` {`)
 							p.In()
-							errorStr := fmt.Sprintf(errorMsgExistsIfAnotherNot[lang], anotherFiledName)
+							errorStr := fmt.Sprintf(messages.MsgExistsIfAnotherNot[lang], p.goFieldPathName(message, anotherFiledName))
 							p.generateErrorStringEmpty(variableName, fieldName, errorStr, validator)
 							p.Out()
 							p.P(`}`)
 						} else if repeated {
-							fmt.Fprintf(os.Stderr, "WARNING: field %v.%v is repeated, validator.msg_exists_if_another_empty has no effect\n", ccTypeName, fieldName)
+							p.warnf(ccTypeName, fieldName, "is repeated, validator.msg_exists_if_another_empty has no effect")
 						} else if !nullable {
-							fmt.Fprintf(os.Stderr, "WARNING: field %v.%v is a nullable=false, validator.msg_exists_if_another_empty has no effect\n", ccTypeName, fieldName)
+							p.warnf(ccTypeName, fieldName, "is a nullable=false, validator.msg_exists_if_another_empty has no effect")
 						}
 					}
 				}
@@ -446,20 +613,7 @@ func (p *plugin) generateProto3Message(file *generator.FileDescriptor, message *
 					// non-nullable fields in proto3 store actual structs, we need pointers to operate on interfaces
 					variableName = "&(" + variableName + ")"
 				}
-				p.P(`if fieldsViolationsChild := `, p.validatorPkg.Use(), `.CallValidatorIfExists(`, variableName, `); fieldsViolationsChild != nil {`)
-				p.In()
-				p.P(`if len(fieldsViolationsChild) > 0 {`)
-				p.In()
-				p.P(`for _, fv := range fieldsViolationsChild {`)
-				p.In()
-				p.P(`fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "`, fieldName, `." + fv.Field, Description: fv.Description}`)
-				p.P(`fieldsViolations = append(fieldsViolations, fieldViolation)`)
-				p.Out()
-				p.P(`}`)
-				p.Out()
-				p.P(`}`)
-				p.Out()
-				p.P(`}`)
+				p.generateNestedValidator(variableName, pathName)
 				if nullable {
 					p.Out()
 					p.P(`}`)
@@ -469,6 +623,7 @@ func (p *plugin) generateProto3Message(file *generator.FileDescriptor, message *
 				// end the repeated loop
 				p.Out()
 				p.P(`}`)
+				p.fieldPath = ""
 			}
 			if isOneOf {
 				// end the oneof if statement
@@ -476,29 +631,213 @@ func (p *plugin) generateProto3Message(file *generator.FileDescriptor, message *
 				p.P(`}`)
 			}
 		}
-		p.P(`if len(fieldsViolations) > 0 {`)
-		p.In()
-		p.P(`return fieldsViolations`)
-		p.Out()
-		p.P(`} else {`)
-		p.In()
+		p.pathName = ""
+		p.generateViolationsReturn()
+
+	} else {
 		p.P(`return nil`)
+	}
+
+	p.Out()
+	p.P(`}`)
+}
+
+func (p *plugin) generateValidateErr(message *generator.Descriptor) {
+	ccTypeName := generator.CamelCaseSlice(message.TypeName())
+	p.P(`func (this *`, ccTypeName, `) ValidateErr() error {`)
+	p.In()
+	p.P(`return `, p.validatorPkg.Use(), `.NewValidationError(this.Validate())`)
+	p.Out()
+	p.P(`}`)
+}
+
+func (p *plugin) generateFieldValidator(field *descriptor.FieldDescriptorProto, variableName string, ccTypeName string, fieldName string, fv *validator.FieldValidator, index int) {
+	if field.IsString() {
+		p.generateStringValidator(variableName, ccTypeName, fieldName, fv, index)
+	} else if p.isSupportedInt(field) {
+		p.generateIntValidator(field, variableName, ccTypeName, fieldName, fv)
+	} else if field.IsEnum() {
+		p.generateEnumValidator(field, variableName, ccTypeName, fieldName, fv)
+	} else if p.isSupportedFloat(field) {
+		p.generateFloatValidator(field, variableName, ccTypeName, fieldName, fv)
+	} else if field.IsBool() {
+		p.generateBoolValidator(variableName, fieldName, fv)
+	} else if valueField := p.wrapperValueField(field); valueField != nil {
+		if hasRulesOfKind(fv, p.fieldKind(valueField)) {
+			p.P(`if wrapper := `, variableName, `; wrapper != nil {`)
+			p.In()
+			p.generateFieldValidator(valueField, "wrapper.Value", ccTypeName, fieldName, fv, index)
+			p.Out()
+			p.P(`}`)
+		}
+	} else if p.isTimestamp(field) {
+		p.generateTimestampValidator(variableName, fieldName, fv)
+	} else if p.isDuration(field) {
+		p.generateDurationValidator(variableName, fieldName, fv)
+	} else if field.IsBytes() {
+		p.generateSubstringValidator(variableName, fieldName, fv, true)
+		p.generateLengthValidator(variableName, ccTypeName, fieldName, fv)
+	}
+}
+
+func (p *plugin) generateMapValidator(
+	file *generator.FileDescriptor,
+	message *generator.Descriptor,
+	field *descriptor.FieldDescriptorProto,
+	variableName, ccTypeName, fieldName string,
+	validators []*validator.FieldValidator) {
+	entry := p.mapEntry(file, message, field)
+	keyField, valueField := entry.Field[0], entry.Field[1]
+	for _, fv := range validators {
+		if fv.MapCountMin != nil {
+			p.P(`if len(`, variableName, `) < `, fv.MapCountMin, ` {`)
+			p.In()
+			errorStr := fmt.Sprintf(messages.MapCountMin[lang], fv.GetMapCountMin())
+			p.generateErrorString(variableName, fieldName, errorStr, fv)
+			p.Out()
+			p.P(`}`)
+		}
+		if fv.MapCountMax != nil {
+			p.P(`if len(`, variableName, `) > `, fv.MapCountMax, ` {`)
+			p.In()
+			errorStr := fmt.Sprintf(messages.MapCountMax[lang], fv.GetMapCountMax())
+			p.generateErrorString(variableName, fieldName, errorStr, fv)
+			p.Out()
+			p.P(`}`)
+		}
+	}
+
+	validateKeys := false
+	validateValues := valueField.IsMessage()
+	for _, fv := range validators {
+		validateKeys = validateKeys || fv.MapKey != nil
+		validateValues = validateValues || fv.MapValue != nil
+	}
+	if !validateKeys && !validateValues {
+		return
+	}
+	if validateValues {
+		p.P(`for key, value := range `, variableName, ` {`)
+	} else {
+		p.P(`for key := range `, variableName, ` {`)
+	}
+	p.In()
+	// Keys are rendered the way they would be written in a Go map literal, e.g. SomeMap["abc"] or SomeMap[3].
+	keyVerb := "%v"
+	if keyField.IsString() {
+		keyVerb = "%q"
+	}
+	entryPath := p.fmtPkg.Use() + `.Sprintf("` + p.pathName + `[` + keyVerb + `]", key)`
+	p.fieldPath = entryPath
+	nullable := gogoproto.IsNullable(field) || !gogoproto.ImportsGoGoProto(file.FileDescriptorProto)
+	valueVariableName := "value"
+	if valueField.IsMessage() && !nullable {
+		// non-nullable map values are stored as structs, we need pointers to operate on interfaces
+		valueVariableName = "&(value)"
+	}
+	for i, fv := range validators {
+		if fv.MapKey != nil {
+			p.generateFieldValidator(keyField, "key", ccTypeName, fieldName+"_key", fv.MapKey, i)
+		}
+		if fv.MapValue != nil {
+			if fv.MapValue.GetMsgExists() && valueField.IsMessage() {
+				if nullable {
+					p.P(`if value == nil {`)
+					p.In()
+					p.generateErrorStringEmpty(valueVariableName, fieldName, messages.MsgExists[lang], fv.MapValue)
+					p.Out()
+					p.P(`}`)
+				} else {
+					p.warnf(ccTypeName, fieldName, "has nullable=false map values, validator.msg_exists has no effect")
+				}
+			}
+			p.generateFieldValidator(valueField, valueVariableName, ccTypeName, fieldName+"_value", fv.MapValue, i)
+		}
+	}
+	p.fieldPath = ""
+	if valueField.IsMessage() {
+		variableName = valueVariableName
+		if nullable {
+			p.P(`if value != nil {`)
+			p.In()
+		}
+		p.fieldPath = entryPath
+		p.generateNestedValidator(variableName, p.pathName)
+		p.fieldPath = ""
+		if nullable {
+			p.Out()
+			p.P(`}`)
+		}
+	}
+	p.Out()
+	p.P(`}`)
+}
+
+// generateNestedValidator validates the message pointed by variableName and appends its violations with their
+// Field prefixed by the path of the field holding it.
+func (p *plugin) generateNestedValidator(variableName string, pathName string) {
+	fieldPath := `"` + pathName + `."`
+	if p.fieldPath != "" {
+		fieldPath = p.fieldPath + ` + "."`
+	}
+	if p.failFast {
+		p.P(`if fv := `, p.validatorPkg.Use(), `.CallFirstValidatorIfExists(`, variableName, `); fv != nil {`)
+		p.In()
+		p.P(`return &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: `, fieldPath, ` + fv.Field, Description: fv.Description}`)
 		p.Out()
 		p.P(`}`)
+		return
+	}
+	p.P(`if fieldsViolationsChild := `, p.validatorPkg.Use(), `.CallValidatorIfExists(`, variableName, `); fieldsViolationsChild != nil {`)
+	p.In()
+	p.P(`for _, fv := range fieldsViolationsChild {`)
+	p.In()
+	p.P(`fieldViolation := &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: `, fieldPath, ` + fv.Field, Description: fv.Description}`)
+	p.P(`fieldsViolations = append(fieldsViolations, fieldViolation)`)
+	p.Out()
+	p.P(`}`)
+	p.Out()
+	p.P(`}`)
+}
 
+func (p *plugin) generateValidateSignature(ccTypeName string) {
+	if p.failFast {
+		p.P(`func (this *`, ccTypeName, `) ValidateFirst() *`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation {`)
 	} else {
-		p.P(`return nil`)
+		p.P(`func (this *`, ccTypeName, `) Validate() []*`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation {`)
 	}
+}
+
+// generateAppendViolation records the fieldViolation variable, or returns it right away in fail-fast mode.
+func (p *plugin) generateAppendViolation() {
+	if p.failFast {
+		p.P(`return fieldViolation`)
+	} else {
+		p.P(`fieldsViolations = append(fieldsViolations, fieldViolation)`)
+	}
+}
 
+func (p *plugin) generateViolationsReturn() {
+	if p.failFast {
+		p.P(`return nil`)
+		return
+	}
+	p.P(`if len(fieldsViolations) > 0 {`)
+	p.In()
+	p.P(`return fieldsViolations`)
+	p.Out()
+	p.P(`} else {`)
+	p.In()
+	p.P(`return nil`)
 	p.Out()
 	p.P(`}`)
 }
 
-func (p *plugin) generateIntValidator(variableName string, ccTypeName string, fieldName string, fv *validator.FieldValidator) {
+func (p *plugin) generateIntValidator(field *descriptor.FieldDescriptorProto, variableName string, ccTypeName string, fieldName string, fv *validator.FieldValidator) {
 	if fv.IntGt != nil {
 		p.P(`if !(`, variableName, ` > `, fv.IntGt, `) {`)
 		p.In()
-		errorStr := fmt.Sprintf(errorIntGt[lang], fv.GetIntGt())
+		errorStr := fmt.Sprintf(messages.IntGt[lang], fv.GetIntGt())
 		p.generateErrorString(variableName, fieldName, errorStr, fv)
 		p.Out()
 		p.P(`}`)
@@ -506,7 +845,7 @@ func (p *plugin) generateIntValidator(variableName string, ccTypeName string, fi
 	if fv.IntLt != nil {
 		p.P(`if !(`, variableName, ` < `, fv.IntLt, `) {`)
 		p.In()
-		errorStr := fmt.Sprintf(errorIntLt[lang], fv.GetIntLt())
+		errorStr := fmt.Sprintf(messages.IntLt[lang], fv.GetIntLt())
 		p.generateErrorString(variableName, fieldName, errorStr, fv)
 		p.Out()
 		p.P(`}`)
@@ -514,7 +853,7 @@ func (p *plugin) generateIntValidator(variableName string, ccTypeName string, fi
 	if fv.IntGte != nil {
 		p.P(`if !(`, variableName, ` >= `, fv.IntGte, `) {`)
 		p.In()
-		errorStr := fmt.Sprintf(errorIntGte[lang], fv.GetIntGte())
+		errorStr := fmt.Sprintf(messages.IntGte[lang], fv.GetIntGte())
 		p.generateErrorString(variableName, fieldName, errorStr, fv)
 		p.Out()
 		p.P(`}`)
@@ -522,11 +861,294 @@ func (p *plugin) generateIntValidator(variableName string, ccTypeName string, fi
 	if fv.IntLte != nil {
 		p.P(`if !(`, variableName, ` <= `, fv.IntLte, `) {`)
 		p.In()
-		errorStr := fmt.Sprintf(errorIntLte[lang], fv.GetIntLte())
+		errorStr := fmt.Sprintf(messages.IntLte[lang], fv.GetIntLte())
 		p.generateErrorString(variableName, fieldName, errorStr, fv)
 		p.Out()
 		p.P(`}`)
 	}
+	if p.isUnsignedInt(field) {
+		// Comparing as uint64 covers the whole range of the bounds whatever the size of the field.
+		bounds := []struct {
+			value    *uint64
+			operator string
+			errorStr string
+		}{
+			{fv.UintGt, ` > `, messages.IntGt[lang]},
+			{fv.UintLt, ` < `, messages.IntLt[lang]},
+			{fv.UintGte, ` >= `, messages.IntGte[lang]},
+			{fv.UintLte, ` <= `, messages.IntLte[lang]},
+		}
+		for _, bound := range bounds {
+			if bound.value == nil {
+				continue
+			}
+			p.P(`if !(uint64(`, variableName, `)`, bound.operator, strconv.FormatUint(*bound.value, 10), `) {`)
+			p.In()
+			p.generateErrorString(variableName, fieldName, fmt.Sprintf(bound.errorStr, *bound.value), fv)
+			p.Out()
+			p.P(`}`)
+		}
+	}
+	if fv.IntMultipleOf != nil && fv.GetIntMultipleOf() != 0 {
+		// Converting to 64 bits keeps the divisor representable whatever the size of the field.
+		if p.isUnsignedInt(field) {
+			magnitude := uint64(fv.GetIntMultipleOf())
+			if fv.GetIntMultipleOf() < 0 {
+				magnitude = uint64(-(fv.GetIntMultipleOf() + 1)) + 1
+			}
+			p.P(`if uint64(`, variableName, `)%`, strconv.FormatUint(magnitude, 10), ` != 0 {`)
+		} else {
+			p.P(`if int64(`, variableName, `)%`, fv.IntMultipleOf, ` != 0 {`)
+		}
+		p.In()
+		p.generateErrorString(variableName, fieldName, fmt.Sprintf(messages.IntMultipleOf[lang], fv.GetIntMultipleOf()), fv)
+		p.Out()
+		p.P(`}`)
+	}
+	// A constant the field cannot hold would not compile in the comparison, checkFieldRules fails the generation.
+	if lower, upper := intFieldRange(field); fv.IntConst != nil && fv.GetIntConst() >= lower && fv.GetIntConst() <= upper {
+		value := strconv.FormatInt(fv.GetIntConst(), 10)
+		p.generateConstValidator(variableName, fieldName, value, value, fv)
+	}
+	if len(fv.IntIn) > 0 || len(fv.IntNotIn) > 0 {
+		// Values the field type cannot hold would not compile as switch cases, and can never match anyway.
+		lower, upper := intFieldRange(field)
+		inRange := func(values []int64) []string {
+			cases := []string{}
+			for _, value := range uniqueInts(values) {
+				if value >= lower && value <= upper {
+					cases = append(cases, strconv.FormatInt(value, 10))
+				}
+			}
+			return cases
+		}
+		if len(fv.IntIn) > 0 {
+			errorStr := fmt.Sprintf(messages.In[lang], valueList(formatInts(uniqueInts(fv.IntIn))))
+			p.generateInValidator(variableName, fieldName, inRange(fv.IntIn), errorStr, fv)
+		}
+		if len(fv.IntNotIn) > 0 {
+			errorStr := fmt.Sprintf(messages.NotIn[lang], valueList(formatInts(uniqueInts(fv.IntNotIn))))
+			p.generateNotInValidator(variableName, fieldName, inRange(fv.IntNotIn), errorStr, fv)
+		}
+	}
+}
+
+func (p *plugin) generateBoolValidator(variableName string, fieldName string, fv *validator.FieldValidator) {
+	if fv.BoolConst != nil {
+		p.generateConstValidator(variableName, fieldName, fmt.Sprint(fv.GetBoolConst()), fmt.Sprint(fv.GetBoolConst()), fv)
+	}
+}
+
+// generateTimestampValidator validates the seconds and nanos of a google.protobuf.Timestamp field, unless it is unset.
+// Timestamps out of range are only reported as such, whatever the other rules.
+func (p *plugin) generateTimestampValidator(variableName string, fieldName string, fv *validator.FieldValidator) {
+	hasBounds := fv.TimestampLt != nil || fv.TimestampGt != nil || fv.GetTimestampLtNow() || fv.GetTimestampGtNow() || fv.TimestampWithin != nil
+	if !hasBounds && !fv.GetTimestampValid() {
+		return
+	}
+	validatorPkg := p.validatorPkg.Use()
+	value := validatorPkg + ".FormatTimestamp(ts.Seconds, ts.Nanos)"
+	p.P(`if ts := `, variableName, `; ts != nil {`)
+	p.In()
+	p.P(`if !`, validatorPkg, `.IsValidTimestamp(ts.Seconds, ts.Nanos) {`)
+	p.In()
+	p.generateErrorString(value, fieldName, messages.TimestampValid[lang], fv)
+	p.Out()
+	if hasBounds {
+		p.P(`} else {`)
+		p.In()
+		for _, bound := range []struct {
+			value    *string
+			operator string
+			errorStr string
+		}{{fv.TimestampLt, ` < 0`, messages.TimestampLt[lang]}, {fv.TimestampGt, ` > 0`, messages.TimestampGt[lang]}} {
+			limit, err := parseTimestamp(bound.value)
+			if err != nil {
+				continue
+			}
+			p.P(`if !(`, validatorPkg, `.CompareTimestamp(ts.Seconds, ts.Nanos, `, strconv.FormatInt(limit.Unix(), 10), `, `, strconv.Itoa(limit.Nanosecond()), `)`, bound.operator, `) {`)
+			p.In()
+			p.generateErrorString(value, fieldName, fmt.Sprintf(bound.errorStr, *bound.value), fv)
+			p.Out()
+			p.P(`}`)
+		}
+		if fv.GetTimestampLtNow() {
+			p.P(`if !(`, validatorPkg, `.CompareTimestampToNow(ts.Seconds, ts.Nanos) < 0) {`)
+			p.In()
+			p.generateErrorString(value, fieldName, messages.TimestampLtNow[lang], fv)
+			p.Out()
+			p.P(`}`)
+		}
+		if fv.GetTimestampGtNow() {
+			p.P(`if !(`, validatorPkg, `.CompareTimestampToNow(ts.Seconds, ts.Nanos) > 0) {`)
+			p.In()
+			p.generateErrorString(value, fieldName, messages.TimestampGtNow[lang], fv)
+			p.Out()
+			p.P(`}`)
+		}
+		if within, err := time.ParseDuration(fv.GetTimestampWithin()); fv.TimestampWithin != nil && err == nil {
+			p.P(`if !`, validatorPkg, `.IsTimestampWithin(ts.Seconds, ts.Nanos, `, strconv.FormatInt(int64(within), 10), `) {`)
+			p.In()
+			p.generateErrorString(value, fieldName, fmt.Sprintf(messages.TimestampWithin[lang], fv.GetTimestampWithin()), fv)
+			p.Out()
+			p.P(`}`)
+		}
+		p.Out()
+	}
+	p.P(`}`)
+	p.Out()
+	p.P(`}`)
+}
+
+// generateDurationValidator validates the seconds and nanos of a google.protobuf.Duration field, unless it is unset.
+func (p *plugin) generateDurationValidator(variableName string, fieldName string, fv *validator.FieldValidator) {
+	if fv.DurationLt == nil && fv.DurationLte == nil && fv.DurationGt == nil && fv.DurationGte == nil && len(fv.DurationIn) == 0 {
+		return
+	}
+	validatorPkg := p.validatorPkg.Use()
+	value := validatorPkg + ".FormatDuration(d.Seconds, d.Nanos)"
+	compare := func(limit time.Duration) string {
+		seconds, nanos := int64(limit/time.Second), int64(limit%time.Second)
+		return validatorPkg + ".CompareDuration(d.Seconds, d.Nanos, " + strconv.FormatInt(seconds, 10) + ", " + strconv.FormatInt(nanos, 10) + ")"
+	}
+	p.P(`if d := `, variableName, `; d != nil {`)
+	p.In()
+	for _, bound := range []struct {
+		value    *string
+		operator string
+		errorStr string
+	}{
+		{fv.DurationLt, ` < 0`, messages.DurationLt[lang]},
+		{fv.DurationLte, ` <= 0`, messages.DurationLte[lang]},
+		{fv.DurationGt, ` > 0`, messages.DurationGt[lang]},
+		{fv.DurationGte, ` >= 0`, messages.DurationGte[lang]},
+	} {
+		limit, err := parseDuration(bound.value)
+		if err != nil {
+			continue
+		}
+		p.P(`if !(`, compare(limit), bound.operator, `) {`)
+		p.In()
+		p.generateErrorString(value, fieldName, fmt.Sprintf(bound.errorStr, messages.FormatDuration(limit, lang)), fv)
+		p.Out()
+		p.P(`}`)
+	}
+	if in, err := parseDurations(fv.DurationIn); len(in) > 0 && err == nil {
+		cases := make([]string, 0, len(in))
+		described := make([]string, 0, len(in))
+		for _, limit := range in {
+			cases = append(cases, compare(limit)+` == 0`)
+			described = append(described, messages.FormatDuration(limit, lang))
+		}
+		p.P(`if !(`, strings.Join(cases, ` || `), `) {`)
+		p.In()
+		p.generateErrorString(value, fieldName, fmt.Sprintf(messages.In[lang], valueList(uniqueStrings(described))), fv)
+		p.Out()
+		p.P(`}`)
+	}
+	p.Out()
+	p.P(`}`)
+}
+
+// parseDuration parses the value of a duration rule, e.g. "1h30m", failing if it is unset.
+func parseDuration(value *string) (time.Duration, error) {
+	if value == nil {
+		return 0, fmt.Errorf("unset duration")
+	}
+	return time.ParseDuration(*value)
+}
+
+// parseDurations parses the values of the duration_in rule.
+func parseDurations(values []string) ([]time.Duration, error) {
+	durations := make([]time.Duration, 0, len(values))
+	for _, value := range values {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, err
+		}
+		durations = append(durations, duration)
+	}
+	return durations, nil
+}
+
+// parseTimestamp parses the RFC 3339 value of a timestamp rule, failing if it is unset.
+func parseTimestamp(value *string) (time.Time, error) {
+	if value == nil {
+		return time.Time{}, fmt.Errorf("unset timestamp")
+	}
+	return time.Parse(time.RFC3339Nano, *value)
+}
+
+// generateConstValidator reports the value unless it equals the Go literal, described as display in the error.
+func (p *plugin) generateConstValidator(variableName string, fieldName string, literal string, display string, fv *validator.FieldValidator) {
+	p.P(`if `, variableName, ` != `, literal, ` {`)
+	p.In()
+	p.generateErrorString(variableName, fieldName, fmt.Sprintf(messages.Const[lang], strings.Replace(display, "%", "%%", -1)), fv)
+	p.Out()
+	p.P(`}`)
+}
+
+// generateInValidator reports the value unless it is one of the cases, given as Go literals.
+func (p *plugin) generateInValidator(variableName string, fieldName string, cases []string, errorStr string, fv *validator.FieldValidator) {
+	p.P(`switch `, variableName, ` {`)
+	if len(cases) > 0 {
+		p.P(`case `, strings.Join(cases, ", "), `:`)
+	}
+	p.P(`default:`)
+	p.In()
+	p.generateErrorString(variableName, fieldName, errorStr, fv)
+	p.Out()
+	p.P(`}`)
+}
+
+// generateNotInValidator reports the value if it is one of the cases, given as Go literals.
+func (p *plugin) generateNotInValidator(variableName string, fieldName string, cases []string, errorStr string, fv *validator.FieldValidator) {
+	if len(cases) == 0 {
+		return
+	}
+	p.P(`switch `, variableName, ` {`)
+	p.P(`case `, strings.Join(cases, ", "), `:`)
+	p.In()
+	p.generateErrorString(variableName, fieldName, errorStr, fv)
+	p.Out()
+	p.P(`}`)
+}
+
+// valueList formats the values of an in or not_in rule for an error description, escaping them for fmt.Sprintf.
+func valueList(values []string) string {
+	return strings.Replace("["+strings.Join(values, ", ")+"]", "%", "%%", -1)
+}
+
+func formatInts(values []int64) []string {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		formatted = append(formatted, strconv.FormatInt(value, 10))
+	}
+	return formatted
+}
+
+func uniqueInts(values []int64) []int64 {
+	seen := map[int64]bool{}
+	unique := []int64{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	unique := []string{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
 }
 
 func (p *plugin) generateEnumValidator(
@@ -537,17 +1159,28 @@ func (p *plugin) generateEnumValidator(
 		enum := p.ObjectNamed(field.GetTypeName()).(*generator.EnumDescriptor)
 		p.P(`if _, ok := `, strings.Join(enum.TypeName(), "_"), "_name[int32(", variableName, ")]; !ok {")
 		p.In()
-		p.generateErrorString(variableName, fieldName, fmt.Sprintf(errorIsInEnum[lang], strings.Join(enum.TypeName(), "_")), fv)
+		p.generateErrorString(variableName, fieldName, fmt.Sprintf(messages.IsInEnum[lang], strings.Join(enum.TypeName(), "_")), fv)
 		p.Out()
 		p.P(`}`)
 	}
+	if fv.EnumConst != nil {
+		enum := p.ObjectNamed(field.GetTypeName()).(*generator.EnumDescriptor)
+		value := fmt.Sprint(fv.GetEnumConst())
+		for _, enumValue := range enum.Value {
+			if enumValue.GetNumber() == fv.GetEnumConst() {
+				value = enumValue.GetName()
+				break
+			}
+		}
+		p.generateConstValidator(variableName, fieldName, fmt.Sprint(fv.GetEnumConst()), value, fv)
+	}
 }
 
 func (p *plugin) generateLengthValidator(variableName string, ccTypeName string, fieldName string, fv *validator.FieldValidator) {
 	if fv.LengthGt != nil {
 		p.P(`if !( len(`, variableName, `) > `, fv.LengthGt, `) {`)
 		p.In()
-		errorStr := fmt.Sprintf(errorLengthGt[lang], fv.GetLengthGt())
+		errorStr := fmt.Sprintf(messages.LengthGt[lang], fv.GetLengthGt())
 		p.generateErrorString(variableName, fieldName, errorStr, fv)
 		p.Out()
 		p.P(`}`)
@@ -556,7 +1189,7 @@ func (p *plugin) generateLengthValidator(variableName string, ccTypeName string,
 	if fv.LengthLt != nil {
 		p.P(`if !( len(`, variableName, `) < `, fv.LengthLt, `) {`)
 		p.In()
-		errorStr := fmt.Sprintf(errorLengthLt[lang], fv.GetLengthLt())
+		errorStr := fmt.Sprintf(messages.LengthLt[lang], fv.GetLengthLt())
 		p.generateErrorString(variableName, fieldName, errorStr, fv)
 		p.Out()
 		p.P(`}`)
@@ -565,23 +1198,23 @@ func (p *plugin) generateLengthValidator(variableName string, ccTypeName string,
 	if fv.LengthEq != nil {
 		p.P(`if !( len(`, variableName, `) == `, fv.LengthEq, `) {`)
 		p.In()
-		errorStr := fmt.Sprintf(errorLengthEq[lang], fv.GetLengthEq())
+		errorStr := fmt.Sprintf(messages.LengthEq[lang], fv.GetLengthEq())
 		p.generateErrorString(variableName, fieldName, errorStr, fv)
 		p.Out()
 		p.P(`}`)
 	}
 }
 
-func (p *plugin) generateFloatValidator(variableName string, ccTypeName string, fieldName string, fv *validator.FieldValidator, index int) {
+func (p *plugin) generateFloatValidator(field *descriptor.FieldDescriptorProto, variableName string, ccTypeName string, fieldName string, fv *validator.FieldValidator) {
 	upperIsStrict := true
 	lowerIsStrict := true
 
 	// First check for incompatible constraints (i.e flt_lt & flt_lte both defined, etc) and determine the real limits.
-	if fv.FloatEpsilon != nil && fv.FloatLt == nil && fv.FloatGt == nil {
-		fmt.Fprintf(os.Stderr, "WARNING: field %v.%v has no 'float_lt' or 'float_gt' field so setting 'float_epsilon' has no effect.", ccTypeName, fieldName)
+	if fv.FloatEpsilon != nil && fv.FloatLt == nil && fv.FloatGt == nil && fv.FloatMultipleOf == nil {
+		p.warnf(ccTypeName, fieldName, "has no 'float_lt', 'float_gt' or 'float_multiple_of' field so setting 'float_epsilon' has no effect")
 	}
 	if fv.FloatLt != nil && fv.FloatLte != nil {
-		fmt.Fprintf(os.Stderr, "WARNING: field %v.%v has both 'float_lt' and 'float_lte' constraints, only the strictest will be used.", ccTypeName, fieldName)
+		p.warnf(ccTypeName, fieldName, "has both 'float_lt' and 'float_lte' constraints, only the strictest will be used")
 		strictLimit := fv.GetFloatLt()
 		if fv.FloatEpsilon != nil {
 			strictLimit += fv.GetFloatEpsilon()
@@ -595,7 +1228,7 @@ func (p *plugin) generateFloatValidator(variableName string, ccTypeName string,
 	}
 
 	if fv.FloatGt != nil && fv.FloatGte != nil {
-		fmt.Fprintf(os.Stderr, "WARNING: field %v.%v has both 'float_gt' and 'float_gte' constraints, only the strictest will be used.", ccTypeName, fieldName)
+		p.warnf(ccTypeName, fieldName, "has both 'float_gt' and 'float_gte' constraints, only the strictest will be used")
 		strictLimit := fv.GetFloatGt()
 		if fv.FloatEpsilon != nil {
 			strictLimit -= fv.GetFloatEpsilon()
@@ -608,20 +1241,43 @@ func (p *plugin) generateFloatValidator(variableName string, ccTypeName string,
 		lowerIsStrict = false
 	}
 
+	// NaN fails every comparison, so the other rules are only checked for the values the finiteness rules accept.
+	finiteCheck := fv.GetFloatFinite() || fv.GetFloatNotNan()
+	otherRules := fv.FloatGt != nil || fv.FloatGte != nil || fv.FloatLt != nil || fv.FloatLte != nil || fv.FloatConst != nil ||
+		fv.FloatMultipleOf != nil || fv.DecimalPlacesLte != nil || fv.DecimalPlacesGte != nil || fv.SignificantDigitsLte != nil
+	if finiteCheck {
+		if fv.GetFloatFinite() {
+			p.P(`if `, p.mathPkg.Use(), `.IsNaN(float64(`, variableName, `)) || `, p.mathPkg.Use(), `.IsInf(float64(`, variableName, `), 0) {`)
+			p.In()
+			p.generateErrorString(variableName, fieldName, messages.FloatFinite[lang], fv)
+		} else {
+			p.P(`if `, p.mathPkg.Use(), `.IsNaN(float64(`, variableName, `)) {`)
+			p.In()
+			p.generateErrorString(variableName, fieldName, messages.FloatNotNaN[lang], fv)
+		}
+		p.Out()
+		if !otherRules {
+			p.P(`}`)
+			return
+		}
+		p.P(`} else {`)
+		p.In()
+	}
+
 	// Generate the constraint checking code.
 	errorStr := ""
 	compareStr := ""
 	if fv.FloatGt != nil || fv.FloatGte != nil {
 		compareStr = fmt.Sprint(`if !(`, variableName)
 		if lowerIsStrict {
-			errorStr = fmt.Sprintf(errorFloatGt[lang], fv.GetFloatGt())
+			errorStr = fmt.Sprintf(messages.FloatGt[lang], fv.GetFloatGt())
 			if fv.FloatEpsilon != nil {
-				errorStr += fmt.Sprintf(errorFloatGtEpsilon[lang], fv.GetFloatEpsilon())
+				errorStr += fmt.Sprintf(messages.FloatGtEpsilon[lang], fv.GetFloatEpsilon())
 				compareStr += fmt.Sprint(` + `, fv.GetFloatEpsilon())
 			}
 			compareStr += fmt.Sprint(` > `, fv.GetFloatGt(), `) {`)
 		} else {
-			errorStr = fmt.Sprintf(errorFloatGte[lang], fv.GetFloatGte())
+			errorStr = fmt.Sprintf(messages.FloatGte[lang], fv.GetFloatGte())
 			compareStr += fmt.Sprint(` >= `, fv.GetFloatGte(), `) {`)
 		}
 		p.P(compareStr)
@@ -634,14 +1290,14 @@ func (p *plugin) generateFloatValidator(variableName string, ccTypeName string,
 	if fv.FloatLt != nil || fv.FloatLte != nil {
 		compareStr = fmt.Sprint(`if !(`, variableName)
 		if upperIsStrict {
-			errorStr = fmt.Sprintf(errorFloatLt[lang], fv.GetFloatLt())
+			errorStr = fmt.Sprintf(messages.FloatLt[lang], fv.GetFloatLt())
 			if fv.FloatEpsilon != nil {
-				errorStr += fmt.Sprintf(errorFloatLtEpsilon[lang], fv.GetFloatEpsilon())
+				errorStr += fmt.Sprintf(messages.FloatLtEpsilon[lang], fv.GetFloatEpsilon())
 				compareStr += fmt.Sprint(` - `, fv.GetFloatEpsilon())
 			}
 			compareStr += fmt.Sprint(` < `, fv.GetFloatLt(), `) {`)
 		} else {
-			errorStr = fmt.Sprintf(errorFloatLte[lang], fv.GetFloatLte())
+			errorStr = fmt.Sprintf(messages.FloatLte[lang], fv.GetFloatLte())
 			compareStr += fmt.Sprint(` <= `, fv.GetFloatLte(), `) {`)
 		}
 		p.P(compareStr)
@@ -651,16 +1307,51 @@ func (p *plugin) generateFloatValidator(variableName string, ccTypeName string,
 		p.P(`}`)
 	}
 
-	if fv.DecimalPlacesLte != nil {
-		floatSlice := fieldName + "_" + fmt.Sprintf("%02d", index)
-		p.P(floatSlice, ` := `, p.stringsPkg.Use(), `.Split(`, p.fmtPkg.Use(), `.Sprintf("%v", `, variableName, `), ".")`)
-		p.P(`if len(`, floatSlice, `) > 1 && !(len(`, floatSlice, `[1]) <= `, fv.DecimalPlacesLte, `) {`)
+	if fv.FloatConst != nil && !math.IsNaN(fv.GetFloatConst()) && !math.IsInf(fv.GetFloatConst(), 0) {
+		p.generateConstValidator(variableName, fieldName, fmt.Sprint(fv.GetFloatConst()), fmt.Sprint(fv.GetFloatConst()), fv)
+	}
+
+	bitSize := "64"
+	if field.GetType() == descriptor.FieldDescriptorProto_TYPE_FLOAT {
+		bitSize = "32"
+	}
+	if fv.FloatMultipleOf != nil {
+		p.P(`if !`, p.validatorPkg.Use(), `.IsMultipleOf(float64(`, variableName, `), `, fmt.Sprint(fv.GetFloatMultipleOf()), `, `, fmt.Sprint(fv.GetFloatEpsilon()), `, `, bitSize, `) {`)
 		p.In()
-		errorStr := fmt.Sprintf(errorDecimalPlacesLte[lang], fv.GetDecimalPlacesLte())
+		errorStr := fmt.Sprintf(messages.FloatMultipleOf[lang], fv.GetFloatMultipleOf())
+		if fv.FloatEpsilon != nil {
+			errorStr += fmt.Sprintf(messages.FloatMultipleOfEpsilon[lang], fv.GetFloatEpsilon())
+		}
 		p.generateErrorString(variableName, fieldName, errorStr, fv)
 		p.Out()
 		p.P(`}`)
 	}
+
+	digitRules := []struct {
+		value    *int32
+		function string
+		operator string
+		errorStr string
+	}{
+		{fv.DecimalPlacesLte, "DecimalPlaces", ` <= `, messages.DecimalPlacesLte[lang]},
+		{fv.DecimalPlacesGte, "DecimalPlaces", ` >= `, messages.DecimalPlacesGte[lang]},
+		{fv.SignificantDigitsLte, "SignificantDigits", ` <= `, messages.SignificantDigitsLte[lang]},
+	}
+	for _, rule := range digitRules {
+		if rule.value == nil {
+			continue
+		}
+		p.P(`if !(`, p.validatorPkg.Use(), `.`, rule.function, `(float64(`, variableName, `), `, bitSize, `)`, rule.operator, rule.value, `) {`)
+		p.In()
+		p.generateErrorString(variableName, fieldName, fmt.Sprintf(rule.errorStr, *rule.value), fv)
+		p.Out()
+		p.P(`}`)
+	}
+
+	if finiteCheck {
+		p.Out()
+		p.P(`}`)
+	}
 }
 
 // getUUIDRegex returns a regex to validate that a string is in UUID
@@ -669,13 +1360,8 @@ func (p *plugin) generateFloatValidator(variableName string, ccTypeName string,
 func getUUIDRegex(version *int32) (string, error) {
 	if version == nil {
 		return "", nil
-	} else if *version < 0 || *version > 5 {
-		return "", fmt.Errorf("UUID version should be between 0-5, Got %d", *version)
-	} else if *version == 0 {
-		return fmt.Sprintf(uuidPattern, "1-5"), nil
-	} else {
-		return fmt.Sprintf(uuidPattern, strconv.Itoa(int(*version))), nil
 	}
+	return messages.UUIDRegex(*version)
 }
 
 func (p *plugin) generateStringValidator(variableName string, ccTypeName string, fieldName string, fv *validator.FieldValidator, index int) {
@@ -683,7 +1369,7 @@ func (p *plugin) generateStringValidator(variableName string, ccTypeName string,
 		if fv.UuidVer != nil {
 			uuid, err := getUUIDRegex(fv.UuidVer)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "WARNING: field %v.%v error %s.\n", ccTypeName, fieldName, err)
+				p.warnf(ccTypeName, fieldName, "error %s", err)
 			} else {
 				fv.Regex = &uuid
 			}
@@ -691,15 +1377,79 @@ func (p *plugin) generateStringValidator(variableName string, ccTypeName string,
 
 		p.P(`if !`, p.regexName(ccTypeName, fieldName, index), `.MatchString(`, variableName, `) {`)
 		p.In()
-		errorStr := errorRegex[lang] + strconv.Quote(fv.GetRegex())
+		errorStr := messages.Regex[lang] + strconv.Quote(fv.GetRegex())
 		p.generateErrorString(variableName, fieldName, errorStr, fv)
 		p.Out()
 		p.P(`}`)
 	}
+	if fv.GetEmail() {
+		p.P(`if !`, p.validatorPkg.Use(), `.IsEmail(`, variableName, `, `, fmt.Sprint(fv.GetEmailRejectDisplayName()), `, `, fmt.Sprint(fv.GetEmailRequireDottedDomain()), `) {`)
+		p.In()
+		p.generateErrorString(variableName, fieldName, messages.Email[lang], fv)
+		p.Out()
+		p.P(`}`)
+	}
+	formats := []struct {
+		enabled  bool
+		function string
+		errorStr string
+	}{
+		{fv.GetHostname(), "IsHostname", messages.Hostname[lang]},
+		{fv.GetIp(), "IsIP", messages.IP[lang]},
+		{fv.GetIpv4(), "IsIPv4", messages.IPv4[lang]},
+		{fv.GetIpv6(), "IsIPv6", messages.IPv6[lang]},
+		{fv.GetCidr(), "IsCIDR", messages.CIDR[lang]},
+	}
+	for _, format := range formats {
+		if !format.enabled {
+			continue
+		}
+		p.P(`if !`, p.validatorPkg.Use(), `.`, format.function, `(`, variableName, `) {`)
+		p.In()
+		p.generateErrorString(variableName, fieldName, format.errorStr, fv)
+		p.Out()
+		p.P(`}`)
+	}
+	if fv.GetUri() || fv.GetUriRef() {
+		schemes := "nil"
+		errorOptions := ""
+		if len(fv.UriSchemes) > 0 {
+			quoted := []string{}
+			for _, scheme := range uniqueStrings(fv.UriSchemes) {
+				quoted = append(quoted, strconv.Quote(scheme))
+			}
+			schemes = "[]string{" + strings.Join(quoted, ", ") + "}"
+			errorOptions += fmt.Sprintf(messages.URISchemes[lang], valueList(quoted))
+		}
+		if fv.GetUriRequireHost() {
+			errorOptions += messages.URIRequireHost[lang]
+		}
+		if fv.GetUriForbidUserinfo() {
+			errorOptions += messages.URIForbidUserinfo[lang]
+		}
+		uris := []struct {
+			enabled  bool
+			function string
+			errorStr string
+		}{
+			{fv.GetUri(), "IsURI", messages.URI[lang]},
+			{fv.GetUriRef(), "IsURIRef", messages.URIRef[lang]},
+		}
+		for _, uri := range uris {
+			if !uri.enabled {
+				continue
+			}
+			p.P(`if !`, p.validatorPkg.Use(), `.`, uri.function, `(`, variableName, `, `, schemes, `, `, fmt.Sprint(fv.GetUriRequireHost()), `, `, fmt.Sprint(fv.GetUriForbidUserinfo()), `) {`)
+			p.In()
+			p.generateErrorString(variableName, fieldName, uri.errorStr+errorOptions, fv)
+			p.Out()
+			p.P(`}`)
+		}
+	}
 	if fv.StringNotEmpty != nil && fv.GetStringNotEmpty() {
 		p.P(`if `, variableName, ` == "" {`)
 		p.In()
-		errorStr := errorStringNotEmpty[lang]
+		errorStr := messages.StringNotEmpty[lang]
 		p.generateErrorStringEmpty(variableName, fieldName, errorStr, fv)
 		p.Out()
 		p.P(`}`)
@@ -707,12 +1457,100 @@ func (p *plugin) generateStringValidator(variableName string, ccTypeName string,
 	if fv.TrimmedStringNotEmpty != nil && fv.GetTrimmedStringNotEmpty() {
 		p.P(`if `, p.stringsPkg.Use(), `.TrimSpace(`, variableName, `) == "" {`)
 		p.In()
-		errorStr := errorTrimmedStringNotEmpty[lang]
+		errorStr := messages.TrimmedStringNotEmpty[lang]
 		p.generateErrorStringEmpty(variableName, fieldName, errorStr, fv)
 		p.Out()
 		p.P(`}`)
 	}
+	if fv.StringConst != nil {
+		p.generateConstValidator(variableName, fieldName, strconv.Quote(fv.GetStringConst()), strconv.Quote(fv.GetStringConst()), fv)
+	}
+	if len(fv.StringIn) > 0 || len(fv.StringNotIn) > 0 {
+		quote := func(values []string) []string {
+			quoted := []string{}
+			for _, value := range uniqueStrings(values) {
+				quoted = append(quoted, strconv.Quote(value))
+			}
+			return quoted
+		}
+		if len(fv.StringIn) > 0 {
+			cases := quote(fv.StringIn)
+			p.generateInValidator(variableName, fieldName, cases, fmt.Sprintf(messages.In[lang], valueList(cases)), fv)
+		}
+		if len(fv.StringNotIn) > 0 {
+			cases := quote(fv.StringNotIn)
+			p.generateNotInValidator(variableName, fieldName, cases, fmt.Sprintf(messages.NotIn[lang], valueList(cases)), fv)
+		}
+	}
+	p.generateSubstringValidator(variableName, fieldName, fv, false)
 	p.generateLengthValidator(variableName, ccTypeName, fieldName, fv)
+	p.generateRuneValidator(variableName, fieldName, fv)
+}
+
+// generateRuneValidator generates the rules counting the characters of a string rather than its bytes.
+func (p *plugin) generateRuneValidator(variableName string, fieldName string, fv *validator.FieldValidator) {
+	if fv.GetValidUtf8() {
+		p.P(`if !`, p.utf8Pkg.Use(), `.ValidString(`, variableName, `) {`)
+		p.In()
+		p.generateErrorString(variableName, fieldName, messages.ValidUTF8[lang], fv)
+		p.Out()
+		p.P(`}`)
+	}
+	rules := []struct {
+		value    *int64
+		operator string
+		errorStr string
+	}{
+		{fv.RunesMin, ` >= `, messages.RunesMin[lang]},
+		{fv.RunesMax, ` <= `, messages.RunesMax[lang]},
+		{fv.RunesEq, ` == `, messages.RunesEq[lang]},
+	}
+	for _, rule := range rules {
+		if rule.value == nil {
+			continue
+		}
+		p.P(`if !(`, p.utf8Pkg.Use(), `.RuneCountInString(`, variableName, `)`, rule.operator, rule.value, `) {`)
+		p.In()
+		p.generateErrorString(variableName, fieldName, fmt.Sprintf(rule.errorStr, *rule.value), fv)
+		p.Out()
+		p.P(`}`)
+	}
+}
+
+// generateSubstringValidator generates the prefix, suffix, contains and not_contains rules of a string or bytes field.
+func (p *plugin) generateSubstringValidator(variableName string, fieldName string, fv *validator.FieldValidator, isBytes bool) {
+	rules := []struct {
+		value    *string
+		negate   bool
+		function string
+		errorStr string
+	}{
+		{fv.Prefix, true, "HasPrefix", messages.Prefix[lang]},
+		{fv.Suffix, true, "HasSuffix", messages.Suffix[lang]},
+		{fv.Contains, true, "Contains", messages.Contains[lang]},
+		{fv.NotContains, false, "Contains", messages.NotContains[lang]},
+	}
+	for _, rule := range rules {
+		if rule.value == nil {
+			continue
+		}
+		literal := strconv.Quote(*rule.value)
+		pkg := p.stringsPkg.Use()
+		if isBytes {
+			pkg = p.bytesPkg.Use()
+			literal = "[]byte(" + literal + ")"
+		}
+		negation := ""
+		if rule.negate {
+			negation = "!"
+		}
+		p.P(`if `, negation, pkg, `.`, rule.function, `(`, variableName, `, `, literal, `) {`)
+		p.In()
+		errorStr := fmt.Sprintf(rule.errorStr, strings.Replace(strconv.Quote(*rule.value), "%", "%%", -1))
+		p.generateErrorString(variableName, fieldName, errorStr, fv)
+		p.Out()
+		p.P(`}`)
+	}
 }
 
 func (p *plugin) generateRepeatedCountValidator(variableName string, ccTypeName string, fieldName string, validators []*validator.FieldValidator) {
@@ -724,7 +1562,7 @@ func (p *plugin) generateRepeatedCountValidator(variableName string, ccTypeName
 			compareStr := fmt.Sprint(`if len(`, variableName, `) < `, fv.GetRepeatedCountMin(), ` {`)
 			p.P(compareStr)
 			p.In()
-			errorStr := fmt.Sprintf(errorRepeatedCountMin[lang], fv.GetRepeatedCountMin())
+			errorStr := fmt.Sprintf(messages.RepeatedCountMin[lang], fv.GetRepeatedCountMin())
 			p.generateErrorString(variableName, fieldName, errorStr, fv)
 			p.Out()
 			p.P(`}`)
@@ -733,7 +1571,7 @@ func (p *plugin) generateRepeatedCountValidator(variableName string, ccTypeName
 			compareStr := fmt.Sprint(`if len(`, variableName, `) > `, fv.GetRepeatedCountMax(), ` {`)
 			p.P(compareStr)
 			p.In()
-			errorStr := fmt.Sprintf(errorRepeatedCountMax[lang], fv.GetRepeatedCountMax())
+			errorStr := fmt.Sprintf(messages.RepeatedCountMax[lang], fv.GetRepeatedCountMax())
 			p.generateErrorString(variableName, fieldName, errorStr, fv)
 			p.Out()
 			p.P(`}`)
@@ -741,22 +1579,92 @@ func (p *plugin) generateRepeatedCountValidator(variableName string, ccTypeName
 	}
 }
 
+// generateErrorString reports a violation of the field. The descriptions are quoted as interpreted string literals
+// since the values of the rules they embed may contain backticks.
 func (p *plugin) generateErrorString(variableName string, fieldName string, specificError string, fv *validator.FieldValidator) {
+	fieldPath := p.fieldPathExpr(fieldName)
 	if fv.GetHumanError() == "" {
-		p.P(`fieldViolation := &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: "`, fieldName, `",`, "Description: fmt.Sprintf(`", errorString[lang], specificError, "`, ", variableName, ")}")
+		p.P(`fieldViolation := &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: `, fieldPath, `,`, `Description: fmt.Sprintf(`, strconv.Quote(messages.String[lang]+specificError), `, `, variableName, `)}`)
 	} else {
-		p.P(`fieldViolation := &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: "`, fieldName, `",`, "Description: `", fv.GetHumanError(), "`}")
+		p.P(`fieldViolation := &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: `, fieldPath, `,`, `Description: `, strconv.Quote(fv.GetHumanError()), `}`)
 	}
-	p.P(`fieldsViolations = append(fieldsViolations, fieldViolation)`)
+	p.generateAppendViolation()
 }
 
 func (p *plugin) generateErrorStringEmpty(variableName string, fieldName string, specificError string, fv *validator.FieldValidator) {
+	fieldPath := p.fieldPathExpr(fieldName)
 	if fv.GetHumanError() == "" {
-		p.P(`fieldViolation := &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: "`, fieldName, `",`, "Description: `", specificError, "`}")
+		p.P(`fieldViolation := &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: `, fieldPath, `,`, `Description: `, strconv.Quote(specificError), `}`)
 	} else {
-		p.P(`fieldViolation := &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: "`, fieldName, `",`, "Description: `", fv.GetHumanError(), "`}")
+		p.P(`fieldViolation := &`, p.errdetailsPkg.Use(), `.BadRequest_FieldViolation{Field: `, fieldPath, `,`, `Description: `, strconv.Quote(fv.GetHumanError()), `}`)
 	}
-	p.P(`fieldsViolations = append(fieldsViolations, fieldViolation)`)
+	p.generateAppendViolation()
+}
+
+// fieldPathExpr returns the Go expression used as the Field of a generated violation.
+func (p *plugin) fieldPathExpr(fieldName string) string {
+	if p.fieldPath != "" {
+		return p.fieldPath
+	}
+	if p.pathName != "" {
+		return `"` + p.pathName + `"`
+	}
+	return `"` + fieldName + `"`
+}
+
+// fieldPathName returns the name of the field in violation paths, following the field_names parameter.
+func (p *plugin) fieldPathName(field *descriptor.FieldDescriptorProto, goName string) string {
+	switch fieldNames {
+	case FieldNamesProto:
+		return field.GetName()
+	case FieldNamesJSON:
+		if field.JsonName != nil {
+			return field.GetJsonName()
+		}
+		return jsonCamelCase(field.GetName())
+	}
+	return goName
+}
+
+// goFieldPathName returns the name in violation paths of the message field with the given Go name, which rules such
+// as msg_exists_if_another_not refer to.
+func (p *plugin) goFieldPathName(message *generator.Descriptor, goName string) string {
+	for _, field := range message.Field {
+		if generator.CamelCase(field.GetName()) == goName {
+			return p.fieldPathName(field, goName)
+		}
+	}
+	return goName
+}
+
+// oneofPathName returns the name of the oneof in violation paths, following the field_names parameter.
+func (p *plugin) oneofPathName(oneof *descriptor.OneofDescriptorProto) string {
+	switch fieldNames {
+	case FieldNamesProto:
+		return oneof.GetName()
+	case FieldNamesJSON:
+		return jsonCamelCase(oneof.GetName())
+	}
+	return generator.CamelCase(oneof.GetName())
+}
+
+// jsonCamelCase mirrors the json_name protoc derives from a field name: underscores are dropped and the letter
+// following them is capitalized.
+func jsonCamelCase(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
 }
 
 func (p *plugin) fieldIsProto3Map(file *generator.FileDescriptor, message *generator.Descriptor, field *descriptor.FieldDescriptorProto) bool {
@@ -785,16 +1693,19 @@ func (p *plugin) fieldIsProto3Map(file *generator.FileDescriptor, message *gener
 	if field.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE || !field.IsRepeated() {
 		return false
 	}
+	return p.mapEntry(file, message, field).GetOptions().GetMapEntry()
+}
+
+// mapEntry returns the descriptor of the message type of a message field, which is the
+// automatically generated MapFieldEntry type for map<> fields.
+func (p *plugin) mapEntry(file *generator.FileDescriptor, message *generator.Descriptor, field *descriptor.FieldDescriptorProto) *descriptor.DescriptorProto {
 	typeName := field.GetTypeName()
-	var msg *descriptor.DescriptorProto
 	if strings.HasPrefix(typeName, ".") {
 		// Fully qualified case, look up in global map, must work or fail badly.
-		msg = p.ObjectNamed(field.GetTypeName()).(*generator.Descriptor).DescriptorProto
-	} else {
-		// Nested, relative case.
-		msg = file.GetNestedMessage(message.DescriptorProto, field.GetTypeName())
+		return p.ObjectNamed(field.GetTypeName()).(*generator.Descriptor).DescriptorProto
 	}
-	return msg.GetOptions().GetMapEntry()
+	// Nested, relative case.
+	return file.GetNestedMessage(message.DescriptorProto, field.GetTypeName())
 }
 
 func (p *plugin) validatorWithMessageExists(validators []*validator.FieldValidator) bool {
@@ -816,15 +1727,13 @@ func (p *plugin) validatorWithNonRepeatedConstraint(validators []*validator.Fiel
 		for i := 0; i < v.NumField(); i++ {
 			fieldName := v.Type().Field(i).Name
 
-			// All known validators will have a pointer type and we should skip any fields
-			// that are not pointers (i.e unknown fields, etc) as well as 'nil' pointers that
-			// don't lead to anything.
-			if v.Type().Field(i).Type.Kind() != reflect.Ptr || v.Field(i).IsNil() {
+			// Skip the fields that do not hold a constraint (i.e unknown fields, 'nil' pointers and empty lists).
+			if !ruleIsSet(v.Type().Field(i), v.Field(i)) {
 				continue
 			}
 
 			// Identify non-repeated constraints based on their name.
-			if fieldName != "RepeatedCountMin" && fieldName != "RepeatedCountMax" {
+			if fieldName != "RepeatedCountMin" && fieldName != "RepeatedCountMax" && !strings.HasPrefix(fieldName, "Map") {
 				return true
 			}
 		}
@@ -832,6 +1741,15 @@ func (p *plugin) validatorWithNonRepeatedConstraint(validators []*validator.Fiel
 	return false
 }
 
+func (p *plugin) validatorWithMapConstraint(validators []*validator.FieldValidator) bool {
+	for _, fv := range validators {
+		if fv != nil && (fv.MapCountMin != nil || fv.MapCountMax != nil || fv.MapKey != nil || fv.MapValue != nil) {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *plugin) regexName(ccTypeName string, fieldName string, index int) string {
 	return "_regex_" + ccTypeName + "_" + fieldName + "_" + fmt.Sprintf("%02d", index)
 }
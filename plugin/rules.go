@@ -0,0 +1,436 @@
+package plugin
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+
+	validator "github.com/lucianoapolo/go-proto-validators"
+)
+
+// ruleKind is a set of field kinds a rule applies to.
+type ruleKind int
+
+const (
+	kindInt ruleKind = 1 << iota
+	kindFloat
+	kindString
+	kindBytes
+	kindEnum
+	kindMessage
+	kindBool
+	// kindUint is the kind of the rules of unsigned integers only, unsigned integer fields being both kindInt and
+	// kindUint.
+	kindUint
+	// kindTimestamp is the kind of the google.protobuf.Timestamp message fields.
+	kindTimestamp
+	// kindDuration is the kind of the google.protobuf.Duration message fields.
+	kindDuration
+)
+
+// ruleKinds tells the kinds of fields each rule, named after its FieldValidator field, applies to. Rules missing
+// from the table apply to any field (human_error) or are checked while generating (repeated and map rules).
+var ruleKinds = map[string]ruleKind{
+	"Regex":                    kindString,
+	"IntGt":                    kindInt,
+	"IntLt":                    kindInt,
+	"IntGte":                   kindInt,
+	"IntLte":                   kindInt,
+	"MsgExists":                kindMessage,
+	"MsgExistsIfAnotherNot":    kindMessage,
+	"FloatGt":                  kindFloat,
+	"FloatLt":                  kindFloat,
+	"FloatEpsilon":             kindFloat,
+	"FloatGte":                 kindFloat,
+	"FloatLte":                 kindFloat,
+	"DecimalPlacesLte":         kindFloat,
+	"StringNotEmpty":           kindString,
+	"TrimmedStringNotEmpty":    kindString,
+	"UuidVer":                  kindString,
+	"LengthGt":                 kindString | kindBytes,
+	"LengthLt":                 kindString | kindBytes,
+	"LengthEq":                 kindString | kindBytes,
+	"IsInEnum":                 kindEnum,
+	"StringIn":                 kindString,
+	"StringNotIn":              kindString,
+	"IntIn":                    kindInt,
+	"IntNotIn":                 kindInt,
+	"Email":                    kindString,
+	"EmailRejectDisplayName":   kindString,
+	"EmailRequireDottedDomain": kindString,
+	"Hostname":                 kindString,
+	"Ip":                       kindString,
+	"Ipv4":                     kindString,
+	"Ipv6":                     kindString,
+	"Cidr":                     kindString,
+	"Uri":                      kindString,
+	"UriRef":                   kindString,
+	"UriSchemes":               kindString,
+	"UriRequireHost":           kindString,
+	"UriForbidUserinfo":        kindString,
+	"Prefix":                   kindString | kindBytes,
+	"Suffix":                   kindString | kindBytes,
+	"Contains":                 kindString | kindBytes,
+	"NotContains":              kindString | kindBytes,
+	"RunesMin":                 kindString,
+	"RunesMax":                 kindString,
+	"RunesEq":                  kindString,
+	"ValidUtf8":                kindString,
+	"BoolConst":                kindBool,
+	"StringConst":              kindString,
+	"IntConst":                 kindInt,
+	"FloatConst":               kindFloat,
+	"EnumConst":                kindEnum,
+	"FloatFinite":              kindFloat,
+	"FloatNotNan":              kindFloat,
+	"UintGt":                   kindUint,
+	"UintLt":                   kindUint,
+	"UintGte":                  kindUint,
+	"UintLte":                  kindUint,
+	"IntMultipleOf":            kindInt,
+	"FloatMultipleOf":          kindFloat,
+	"DecimalPlacesGte":         kindFloat,
+	"SignificantDigitsLte":     kindFloat,
+	"TimestampLt":              kindTimestamp,
+	"TimestampGt":              kindTimestamp,
+	"TimestampLtNow":           kindTimestamp,
+	"TimestampGtNow":           kindTimestamp,
+	"TimestampWithin":          kindTimestamp,
+	"TimestampValid":           kindTimestamp,
+	"DurationLt":               kindDuration,
+	"DurationLte":              kindDuration,
+	"DurationGt":               kindDuration,
+	"DurationGte":              kindDuration,
+	"DurationIn":               kindDuration,
+}
+
+// warnf reports a problem with the rules of a field once. It is printed as a warning, or reported as the generation
+// error in strict mode.
+func (p *plugin) warnf(ccTypeName string, fieldName string, format string, args ...interface{}) {
+	problem := fmt.Sprintf("field %v.%v ", ccTypeName, fieldName) + fmt.Sprintf(format, args...)
+	located := p.fileName + ": " + problem
+	for _, known := range p.problems {
+		if known == located {
+			return
+		}
+	}
+	p.problems = append(p.problems, located)
+	if !strict {
+		fmt.Fprintf(os.Stderr, "WARNING: %s\n", problem)
+	}
+}
+
+// errorf reports a problem with the rules of a field that would make the generated code invalid once. It fails the
+// generation, strict mode or not.
+func (p *plugin) errorf(ccTypeName string, fieldName string, format string, args ...interface{}) {
+	located := p.fileName + ": " + fmt.Sprintf("field %v.%v ", ccTypeName, fieldName) + fmt.Sprintf(format, args...)
+	for _, known := range p.failures {
+		if known == located {
+			return
+		}
+	}
+	p.failures = append(p.failures, located)
+}
+
+func (p *plugin) fieldKind(field *descriptor.FieldDescriptorProto) ruleKind {
+	switch {
+	case p.isUnsignedInt(field):
+		return kindInt | kindUint
+	case p.isSupportedInt(field):
+		return kindInt
+	case p.isSupportedFloat(field):
+		return kindFloat
+	case field.IsString():
+		return kindString
+	case field.IsBytes():
+		return kindBytes
+	case field.IsEnum():
+		return kindEnum
+	case p.wrapperValueField(field) != nil:
+		return kindMessage | p.fieldKind(p.wrapperValueField(field))
+	case p.isTimestamp(field):
+		return kindMessage | kindTimestamp
+	case p.isDuration(field):
+		return kindMessage | kindDuration
+	case field.IsMessage():
+		return kindMessage
+	case field.IsBool():
+		return kindBool
+	}
+	return 0
+}
+
+// checkMessage reports the rules of the message fields that cannot apply to their field, or that no value can
+// satisfy.
+func (p *plugin) checkMessage(file *generator.FileDescriptor, message *generator.Descriptor) {
+	ccTypeName := generator.CamelCaseSlice(message.TypeName())
+	for _, field := range message.Field {
+		fieldName := p.GetOneOfFieldName(message, field)
+		isMap := p.fieldIsProto3Map(file, message, field)
+		for _, fv := range getFieldValidatorIfAny(field) {
+			p.checkFieldRules(ccTypeName, fieldName, field, fv)
+			if !isMap {
+				continue
+			}
+			entry := p.mapEntry(file, message, field)
+			if fv.MapKey != nil {
+				p.checkFieldRules(ccTypeName, fieldName+"[key]", entry.Field[0], fv.MapKey)
+			}
+			if fv.MapValue != nil {
+				p.checkFieldRules(ccTypeName, fieldName+"[value]", entry.Field[1], fv.MapValue)
+			}
+		}
+	}
+}
+
+func (p *plugin) checkFieldRules(ccTypeName string, fieldName string, field *descriptor.FieldDescriptorProto, fv *validator.FieldValidator) {
+	kind := p.fieldKind(field)
+	if valueField := p.wrapperValueField(field); valueField != nil {
+		// The rules of the wrapper types but msg_exists apply to their Value.
+		field = valueField
+	}
+	v := reflect.ValueOf(*fv)
+	for i := 0; i < v.NumField(); i++ {
+		if !ruleIsSet(v.Type().Field(i), v.Field(i)) {
+			continue
+		}
+		if kinds, ok := ruleKinds[v.Type().Field(i).Name]; ok && kinds&kind == 0 {
+			typeName := strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+			p.warnf(ccTypeName, fieldName, "has rule '%s' which does not apply to %s fields", ruleName(v.Type().Field(i)), typeName)
+		}
+	}
+	if fv.Regex != nil && fv.UuidVer == nil {
+		if _, err := regexp.Compile(fv.GetRegex()); err != nil {
+			p.errorf(ccTypeName, fieldName, "has an invalid 'regex', which would panic when the generated code is loaded: %v", err)
+		}
+	}
+	if !fv.GetEmail() && (fv.EmailRejectDisplayName != nil || fv.EmailRequireDottedDomain != nil) {
+		p.warnf(ccTypeName, fieldName, "has 'email' options without the 'email' rule")
+	}
+	if !fv.GetUri() && !fv.GetUriRef() && (len(fv.UriSchemes) > 0 || fv.UriRequireHost != nil || fv.UriForbidUserinfo != nil) {
+		p.warnf(ccTypeName, fieldName, "has 'uri' options without the 'uri' or 'uri_ref' rule")
+	}
+	if kind&kindUint != 0 {
+		for _, bound := range []struct {
+			value *int64
+			name  string
+		}{{fv.IntGt, "int_gt"}, {fv.IntLt, "int_lt"}, {fv.IntGte, "int_gte"}, {fv.IntLte, "int_lte"}} {
+			if bound.value != nil && *bound.value < 0 {
+				p.errorf(ccTypeName, fieldName, "has negative '%s' %d, which cannot be compared with unsigned values, use the 'uint_*' rules", bound.name, *bound.value)
+			}
+		}
+	}
+	if kind&kindInt != 0 {
+		lower, upper := intFieldRange(field)
+		typeName := strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+		if fv.IntConst != nil && (fv.GetIntConst() < lower || fv.GetIntConst() > upper) {
+			p.errorf(ccTypeName, fieldName, "has 'int_const' value %d which %s fields cannot hold, no value would be valid", fv.GetIntConst(), typeName)
+		}
+		for _, value := range fv.IntIn {
+			if value < lower || value > upper {
+				p.warnf(ccTypeName, fieldName, "has 'int_in' value %d which %s fields cannot hold", value, typeName)
+			}
+		}
+		for _, value := range fv.IntNotIn {
+			if value < lower || value > upper {
+				p.warnf(ccTypeName, fieldName, "has 'int_not_in' value %d which %s fields cannot hold", value, typeName)
+			}
+		}
+	}
+	if fv.IntMultipleOf != nil && fv.GetIntMultipleOf() == 0 {
+		p.errorf(ccTypeName, fieldName, "has 'int_multiple_of' 0, which would divide by zero")
+	}
+	if fv.FloatMultipleOf != nil && (!(fv.GetFloatMultipleOf() > 0) || math.IsInf(fv.GetFloatMultipleOf(), 0)) {
+		p.warnf(ccTypeName, fieldName, "has 'float_multiple_of' %v, which is not a positive finite number", fv.GetFloatMultipleOf())
+	}
+	if fv.FloatConst != nil && (math.IsNaN(fv.GetFloatConst()) || math.IsInf(fv.GetFloatConst(), 0)) {
+		p.warnf(ccTypeName, fieldName, "has 'float_const' %v, which is not a finite number", fv.GetFloatConst())
+	}
+	for _, bound := range []struct {
+		value *string
+		name  string
+	}{{fv.TimestampLt, "timestamp_lt"}, {fv.TimestampGt, "timestamp_gt"}} {
+		if _, err := parseTimestamp(bound.value); bound.value != nil && err != nil {
+			p.errorf(ccTypeName, fieldName, "has an invalid '%s', which must be an RFC 3339 timestamp: %v", bound.name, err)
+		}
+	}
+	if within, err := time.ParseDuration(fv.GetTimestampWithin()); fv.TimestampWithin != nil && err != nil {
+		p.errorf(ccTypeName, fieldName, "has an invalid 'timestamp_within': %v", err)
+	} else if fv.TimestampWithin != nil && within < 0 {
+		p.warnf(ccTypeName, fieldName, "has a negative 'timestamp_within', which no value can satisfy")
+	}
+	for _, bound := range []struct {
+		value *string
+		name  string
+	}{{fv.DurationLt, "duration_lt"}, {fv.DurationLte, "duration_lte"}, {fv.DurationGt, "duration_gt"}, {fv.DurationGte, "duration_gte"}} {
+		if _, err := parseDuration(bound.value); bound.value != nil && err != nil {
+			p.errorf(ccTypeName, fieldName, "has an invalid '%s': %v", bound.name, err)
+		}
+	}
+	if _, err := parseDurations(fv.DurationIn); err != nil {
+		p.errorf(ccTypeName, fieldName, "has an invalid 'duration_in': %v", err)
+	}
+	p.checkBounds(ccTypeName, fieldName, fv)
+}
+
+// checkBounds reports lower and upper bounds that no value can satisfy together.
+func (p *plugin) checkBounds(ccTypeName string, fieldName string, fv *validator.FieldValidator) {
+	if lower, upper, ok := intRange(fv.IntGt, fv.IntGte, fv.IntLt, fv.IntLte); !ok {
+		p.warnf(ccTypeName, fieldName, "has contradictory int bounds, no int64 value is beyond them")
+	} else if lower > upper {
+		p.warnf(ccTypeName, fieldName, "has contradictory int bounds, no value is at least %d and at most %d", lower, upper)
+	}
+	if lower, upper, ok := uintRange(fv.UintGt, fv.UintGte, fv.UintLt, fv.UintLte); !ok || lower > upper {
+		p.warnf(ccTypeName, fieldName, "has contradictory uint bounds")
+	}
+	if fv.FloatGt != nil && fv.FloatLt != nil && fv.GetFloatGt() >= fv.GetFloatLt() ||
+		fv.FloatGt != nil && fv.FloatLte != nil && fv.GetFloatGt() >= fv.GetFloatLte() ||
+		fv.FloatGte != nil && fv.FloatLt != nil && fv.GetFloatGte() >= fv.GetFloatLt() ||
+		fv.FloatGte != nil && fv.FloatLte != nil && fv.GetFloatGte() > fv.GetFloatLte() {
+		p.warnf(ccTypeName, fieldName, "has contradictory float bounds")
+	}
+	if lower, upper, ok := intRange(fv.LengthGt, fv.LengthEq, fv.LengthLt, fv.LengthEq); !ok {
+		p.warnf(ccTypeName, fieldName, "has contradictory length bounds, no length is beyond them")
+	} else if lower > upper {
+		p.warnf(ccTypeName, fieldName, "has contradictory length bounds, no length is at least %d and at most %d", lower, upper)
+	}
+	if fv.DecimalPlacesGte != nil && fv.DecimalPlacesLte != nil && fv.GetDecimalPlacesGte() > fv.GetDecimalPlacesLte() {
+		p.warnf(ccTypeName, fieldName, "has 'decimal_places_gte' greater than 'decimal_places_lte'")
+	}
+	if lower, err := parseTimestamp(fv.TimestampGt); err == nil {
+		if upper, err := parseTimestamp(fv.TimestampLt); err == nil && !lower.Before(upper) {
+			p.warnf(ccTypeName, fieldName, "has 'timestamp_gt' not before 'timestamp_lt'")
+		}
+	}
+	gt, gtErr := parseDuration(fv.DurationGt)
+	gte, gteErr := parseDuration(fv.DurationGte)
+	lt, ltErr := parseDuration(fv.DurationLt)
+	lte, lteErr := parseDuration(fv.DurationLte)
+	if gtErr == nil && ltErr == nil && gt >= lt ||
+		gtErr == nil && lteErr == nil && gt >= lte ||
+		gteErr == nil && ltErr == nil && gte >= lt ||
+		gteErr == nil && lteErr == nil && gte > lte {
+		p.warnf(ccTypeName, fieldName, "has contradictory duration bounds")
+	}
+	if fv.GetTimestampLtNow() && fv.GetTimestampGtNow() {
+		p.warnf(ccTypeName, fieldName, "has both 'timestamp_lt_now' and 'timestamp_gt_now'")
+	}
+	if fv.RunesMin != nil && fv.RunesMax != nil && fv.GetRunesMin() > fv.GetRunesMax() {
+		p.warnf(ccTypeName, fieldName, "has 'runes_min' greater than 'runes_max'")
+	}
+	if fv.RunesEq != nil && (fv.RunesMin != nil && fv.GetRunesEq() < fv.GetRunesMin() || fv.RunesMax != nil && fv.GetRunesEq() > fv.GetRunesMax()) {
+		p.warnf(ccTypeName, fieldName, "has 'runes_eq' outside of 'runes_min' and 'runes_max'")
+	}
+	if fv.RepeatedCountMin != nil && fv.RepeatedCountMax != nil && fv.GetRepeatedCountMin() > fv.GetRepeatedCountMax() {
+		p.warnf(ccTypeName, fieldName, "has 'repeated_count_min' greater than 'repeated_count_max'")
+	}
+	if fv.MapCountMin != nil && fv.MapCountMax != nil && fv.GetMapCountMin() > fv.GetMapCountMax() {
+		p.warnf(ccTypeName, fieldName, "has 'map_count_min' greater than 'map_count_max'")
+	}
+}
+
+// intFieldRange returns the inclusive range of the values an integer field can hold, within int64.
+func intFieldRange(field *descriptor.FieldDescriptorProto) (lower int64, upper int64) {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32, descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return math.MinInt32, math.MaxInt32
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return 0, math.MaxUint32
+	case descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return 0, math.MaxInt64
+	}
+	return math.MinInt64, math.MaxInt64
+}
+
+// intRange returns the inclusive range allowed by the given bounds, ok being false if a bound alone excludes every
+// value, e.g. int_gt: 9223372036854775807.
+func intRange(gt, gte, lt, lte *int64) (lower int64, upper int64, ok bool) {
+	lower, upper = math.MinInt64, math.MaxInt64
+	if gt != nil {
+		if *gt == math.MaxInt64 {
+			return 0, 0, false
+		}
+		lower = *gt + 1
+	}
+	if gte != nil && *gte > lower {
+		lower = *gte
+	}
+	if lt != nil {
+		if *lt == math.MinInt64 {
+			return 0, 0, false
+		}
+		upper = *lt - 1
+	}
+	if lte != nil && *lte < upper {
+		upper = *lte
+	}
+	return lower, upper, true
+}
+
+// uintRange returns the inclusive range allowed by the given unsigned bounds, ok being false if a bound alone excludes
+// every value, e.g. uint_lt: 0.
+func uintRange(gt, gte, lt, lte *uint64) (lower uint64, upper uint64, ok bool) {
+	lower, upper = 0, math.MaxUint64
+	if gt != nil {
+		if *gt == math.MaxUint64 {
+			return 0, 0, false
+		}
+		lower = *gt + 1
+	}
+	if gte != nil && *gte > lower {
+		lower = *gte
+	}
+	if lt != nil {
+		if *lt == 0 {
+			return 0, 0, false
+		}
+		upper = *lt - 1
+	}
+	if lte != nil && *lte < upper {
+		upper = *lte
+	}
+	return lower, upper, true
+}
+
+// hasRulesOfKind tells whether the FieldValidator has rules applying to fields of the given kind.
+func hasRulesOfKind(fv *validator.FieldValidator, kind ruleKind) bool {
+	v := reflect.ValueOf(*fv)
+	for i := 0; i < v.NumField(); i++ {
+		if ruleIsSet(v.Type().Field(i), v.Field(i)) && ruleKinds[v.Type().Field(i).Name]&kind != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleIsSet tells whether a FieldValidator field holds a rule, i.e. it is a non-nil option or a non-empty list of
+// values.
+func ruleIsSet(field reflect.StructField, value reflect.Value) bool {
+	if strings.HasPrefix(field.Name, "XXX_") {
+		return false
+	}
+	switch value.Kind() {
+	case reflect.Ptr:
+		return !value.IsNil()
+	case reflect.Slice:
+		return value.Len() > 0
+	}
+	return false
+}
+
+// ruleName returns the .proto name of a FieldValidator field.
+func ruleName(field reflect.StructField) string {
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+	return field.Name
+}
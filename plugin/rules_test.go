@@ -0,0 +1,207 @@
+// Copyright 2016 Michal Witkowski. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package plugin
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	plugin_go "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	validator "github.com/lucianoapolo/go-proto-validators"
+)
+
+// ruleField builds a field of the given type carrying the validator rules.
+func ruleField(name string, number int32, fieldType descriptor.FieldDescriptorProto_Type, fv *validator.FieldValidator) *descriptor.FieldDescriptorProto {
+	options := &descriptor.FieldOptions{}
+	if err := proto.SetExtension(options, validator.E_Field, []*validator.FieldValidator{fv}); err != nil {
+		panic(err)
+	}
+	return &descriptor.FieldDescriptorProto{
+		Name:     proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     fieldType.Enum(),
+		JsonName: proto.String(name),
+		Options:  options,
+	}
+}
+
+// ruleFile builds a proto3 file with a single message made of the fields.
+func ruleFile(name string, dependencies []string, fields ...*descriptor.FieldDescriptorProto) *descriptor.FileDescriptorProto {
+	return &descriptor.FileDescriptorProto{
+		Name:       proto.String(name),
+		Package:    proto.String("rules"),
+		Syntax:     proto.String("proto3"),
+		Dependency: dependencies,
+		Options:    &descriptor.FileOptions{GoPackage: proto.String("example.com/rules")},
+		MessageType: []*descriptor.DescriptorProto{{
+			Name:  proto.String(strings.Title(strings.TrimSuffix(name, ".proto")) + "Message"),
+			Field: fields,
+		}},
+	}
+}
+
+// generate runs the plugin like protoc-gen-govalidators does, for the files to generate among the given ones.
+func generate(t *testing.T, strictMode bool, filesToGenerate []string, files ...*descriptor.FileDescriptorProto) (*plugin, *plugin_go.CodeGeneratorResponse) {
+	t.Helper()
+	return generateImport(t, strictMode, false, filesToGenerate, files...)
+}
+
+// generateImport is generate with the gogoimport parameter.
+func generateImport(t *testing.T, strictMode bool, useGogoImport bool, filesToGenerate []string, files ...*descriptor.FileDescriptorProto) (*plugin, *plugin_go.CodeGeneratorResponse) {
+	t.Helper()
+	previous := strict
+	SetStrict(strictMode)
+	defer SetStrict(previous)
+	SetLanguage(LangDefault)
+	SetFieldNames(FieldNamesGo)
+
+	// Without gogoimport the plugin modifies the options of the files, which the callers may share between runs.
+	clones := make([]*descriptor.FileDescriptorProto, 0, len(files))
+	for _, file := range files {
+		clones = append(clones, proto.Clone(file).(*descriptor.FileDescriptorProto))
+	}
+	gen := generator.New()
+	gen.Request = &plugin_go.CodeGeneratorRequest{FileToGenerate: filesToGenerate, ProtoFile: clones}
+	gen.CommandLineParameters("")
+	gen.WrapTypes()
+	gen.SetPackageNames()
+	gen.BuildTypeNameMap()
+	p := NewPlugin(useGogoImport).(*plugin)
+	gen.GeneratePlugin(p)
+	return p, gen.Response
+}
+
+func TestRules_WrongFieldTypeWarns(t *testing.T) {
+	file := ruleFile("wrong.proto", nil,
+		ruleField("name", 1, descriptor.FieldDescriptorProto_TYPE_STRING, &validator.FieldValidator{IntGt: proto.Int64(5)}),
+		ruleField("age", 2, descriptor.FieldDescriptorProto_TYPE_INT32, &validator.FieldValidator{StringNotEmpty: proto.Bool(true)}),
+	)
+	p, response := generate(t, false, []string{"wrong.proto"}, file)
+	assert.Equal(t, []string{
+		"wrong.proto: field WrongMessage.Name has rule 'int_gt' which does not apply to string fields",
+		"wrong.proto: field WrongMessage.Age has rule 'string_not_empty' which does not apply to int32 fields",
+	}, p.problems)
+	assert.Empty(t, p.failures)
+	assert.Nil(t, response.Error, "warnings must not fail the generation outside strict mode")
+	assert.Len(t, response.File, 1)
+}
+
+func TestRules_ContradictoryBoundsWarn(t *testing.T) {
+	file := ruleFile("bounds.proto", nil,
+		ruleField("age", 1, descriptor.FieldDescriptorProto_TYPE_INT32, &validator.FieldValidator{IntGt: proto.Int64(10), IntLt: proto.Int64(5)}),
+		ruleField("name", 2, descriptor.FieldDescriptorProto_TYPE_STRING, &validator.FieldValidator{LengthGt: proto.Int64(8), LengthLt: proto.Int64(3)}),
+		ruleField("ratio", 3, descriptor.FieldDescriptorProto_TYPE_DOUBLE, &validator.FieldValidator{FloatGt: proto.Float64(1), FloatLt: proto.Float64(0)}),
+	)
+	p, _ := generate(t, false, []string{"bounds.proto"}, file)
+	assert.Equal(t, []string{
+		"bounds.proto: field BoundsMessage.Age has contradictory int bounds, no value is at least 11 and at most 4",
+		"bounds.proto: field BoundsMessage.Name has contradictory length bounds, no length is at least 9 and at most 2",
+		"bounds.proto: field BoundsMessage.Ratio has contradictory float bounds",
+	}, p.problems)
+	assert.Empty(t, p.failures)
+}
+
+func TestRules_IntBoundsAtTheLimits(t *testing.T) {
+	file := ruleFile("limits.proto", nil,
+		ruleField("above", 1, descriptor.FieldDescriptorProto_TYPE_INT64, &validator.FieldValidator{IntGt: proto.Int64(math.MaxInt64)}),
+		ruleField("below", 2, descriptor.FieldDescriptorProto_TYPE_INT64, &validator.FieldValidator{IntLt: proto.Int64(math.MinInt64)}),
+		ruleField("max", 3, descriptor.FieldDescriptorProto_TYPE_INT64, &validator.FieldValidator{IntGt: proto.Int64(math.MaxInt64 - 1), IntLte: proto.Int64(math.MaxInt64)}),
+		ruleField("min", 4, descriptor.FieldDescriptorProto_TYPE_INT64, &validator.FieldValidator{IntGte: proto.Int64(math.MinInt64), IntLt: proto.Int64(math.MinInt64 + 1)}),
+		ruleField("name", 5, descriptor.FieldDescriptorProto_TYPE_STRING, &validator.FieldValidator{LengthGt: proto.Int64(math.MaxInt64)}),
+	)
+	p, _ := generate(t, false, []string{"limits.proto"}, file)
+	assert.Equal(t, []string{
+		"limits.proto: field LimitsMessage.Above has contradictory int bounds, no int64 value is beyond them",
+		"limits.proto: field LimitsMessage.Below has contradictory int bounds, no int64 value is beyond them",
+		"limits.proto: field LimitsMessage.Name has contradictory length bounds, no length is beyond them",
+	}, p.problems)
+	assert.Empty(t, p.failures)
+}
+
+func TestRules_InvalidCodeFailsInEveryMode(t *testing.T) {
+	file := ruleFile("invalid.proto", nil,
+		ruleField("code", 1, descriptor.FieldDescriptorProto_TYPE_STRING, &validator.FieldValidator{Regex: proto.String("([a-z]")}),
+		ruleField("count", 2, descriptor.FieldDescriptorProto_TYPE_UINT32, &validator.FieldValidator{IntGt: proto.Int64(-1)}),
+		ruleField("step", 3, descriptor.FieldDescriptorProto_TYPE_INT64, &validator.FieldValidator{IntMultipleOf: proto.Int64(0)}),
+	)
+	for _, strictMode := range []bool{false, true} {
+		p, response := generate(t, strictMode, []string{"invalid.proto"}, file)
+		assert.Empty(t, p.problems)
+		require.Len(t, p.failures, 3)
+		assert.Contains(t, p.failures[0], "invalid.proto: field InvalidMessage.Code has an invalid 'regex'")
+		assert.Equal(t, "invalid.proto: field InvalidMessage.Count has negative 'int_gt' -1, which cannot be compared with unsigned values, use the 'uint_*' rules", p.failures[1])
+		assert.Equal(t, "invalid.proto: field InvalidMessage.Step has 'int_multiple_of' 0, which would divide by zero", p.failures[2])
+		require.NotNil(t, response.Error, "strict=%v", strictMode)
+		assert.Equal(t, strings.Join(p.failures, "\n"), response.GetError())
+	}
+}
+
+func TestRules_IntConstOutOfRangeFails(t *testing.T) {
+	file := ruleFile("const.proto", nil,
+		ruleField("small", 1, descriptor.FieldDescriptorProto_TYPE_INT32, &validator.FieldValidator{IntConst: proto.Int64(1 << 40)}),
+		ruleField("count", 2, descriptor.FieldDescriptorProto_TYPE_UINT32, &validator.FieldValidator{IntConst: proto.Int64(-1)}),
+	)
+	p, response := generate(t, false, []string{"const.proto"}, file)
+	assert.Empty(t, p.problems)
+	assert.Equal(t, []string{
+		"const.proto: field ConstMessage.Small has 'int_const' value 1099511627776 which int32 fields cannot hold, no value would be valid",
+		"const.proto: field ConstMessage.Count has 'int_const' value -1 which uint32 fields cannot hold, no value would be valid",
+	}, p.failures)
+	assert.NotNil(t, response.Error)
+}
+
+func TestRules_StrictModeFailsOnWarnings(t *testing.T) {
+	file := ruleFile("strict.proto", nil,
+		ruleField("name", 1, descriptor.FieldDescriptorProto_TYPE_STRING, &validator.FieldValidator{IntGt: proto.Int64(5)}),
+	)
+	_, response := generate(t, true, []string{"strict.proto"}, file)
+	assert.Equal(t, "strict.proto: field StrictMessage.Name has rule 'int_gt' which does not apply to string fields", response.GetError())
+}
+
+func TestRules_ImportedFilesAreNotChecked(t *testing.T) {
+	dependency := ruleFile("dependency.proto", nil,
+		ruleField("name", 1, descriptor.FieldDescriptorProto_TYPE_STRING, &validator.FieldValidator{IntGt: proto.Int64(5)}),
+		ruleField("code", 2, descriptor.FieldDescriptorProto_TYPE_STRING, &validator.FieldValidator{Regex: proto.String("([a-z]")}),
+	)
+	file := ruleFile("main.proto", []string{"dependency.proto"},
+		ruleField("age", 1, descriptor.FieldDescriptorProto_TYPE_INT32, &validator.FieldValidator{IntGt: proto.Int64(0)}),
+	)
+	p, response := generate(t, true, []string{"main.proto"}, dependency, file)
+	assert.Empty(t, p.problems)
+	assert.Empty(t, p.failures)
+	assert.Nil(t, response.Error)
+}
+
+func TestRules_BacktickValuesAreQuoted(t *testing.T) {
+	testcases := map[string]struct {
+		fv          *validator.FieldValidator
+		description string
+	}{
+		"prefix":        {&validator.FieldValidator{Prefix: proto.String("`a")}, "must start with \"`a\""},
+		"suffix":        {&validator.FieldValidator{Suffix: proto.String("a`")}, "must end with \"a`\""},
+		"contains":      {&validator.FieldValidator{Contains: proto.String("`")}, "must contain \"`\""},
+		"not_contains":  {&validator.FieldValidator{NotContains: proto.String("``")}, "must not contain \"``\""},
+		"string_in":     {&validator.FieldValidator{StringIn: []string{"`a`", "b"}}, "must be one of [\"`a`\", \"b\"]"},
+		"string_not_in": {&validator.FieldValidator{StringNotIn: []string{"`"}}, "must not be one of [\"`\"]"},
+		"string_const":  {&validator.FieldValidator{StringConst: proto.String("`v1`")}, "must be equal to \"`v1`\""},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			file := ruleFile("quoted.proto", nil, ruleField("name", 1, descriptor.FieldDescriptorProto_TYPE_STRING, tc.fv))
+			_, response := generate(t, true, []string{"quoted.proto"}, file)
+			require.Nil(t, response.Error, response.GetError())
+			quoted := strconv.Quote(tc.description)
+			assert.Contains(t, response.File[0].GetContent(), quoted[1:len(quoted)-1])
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: golden.proto
+
+package golden
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/lucianoapolo/go-proto-validators"
+	github_com_lucianoapolo_go_proto_validators "github.com/lucianoapolo/go-proto-validators"
+	google_golang_org_genproto_googleapis_rpc_errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	math "math"
+	regexp "regexp"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+var _regex_Outer_ByName_key_00 = regexp.MustCompile(`^[a-z]+$`)
+
+func (this *Outer) Validate() []*google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{}
+	if this.InnerReq != nil {
+		if fieldsViolationsChild := github_com_lucianoapolo_go_proto_validators.CallValidatorIfExists(this.InnerReq); fieldsViolationsChild != nil {
+			for _, fv := range fieldsViolationsChild {
+				fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "InnerReq." + fv.Field, Description: fv.Description}
+				fieldsViolations = append(fieldsViolations, fieldViolation)
+			}
+		}
+	}
+	if this.InnerNonNull != nil {
+		if fieldsViolationsChild := github_com_lucianoapolo_go_proto_validators.CallValidatorIfExists(this.InnerNonNull); fieldsViolationsChild != nil {
+			for _, fv := range fieldsViolationsChild {
+				fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "InnerNonNull." + fv.Field, Description: fv.Description}
+				fieldsViolations = append(fieldsViolations, fieldViolation)
+			}
+		}
+	}
+	if len(this.InnerRep) > 3 {
+		fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "InnerRep", Description: fmt.Sprintf("value '%v' must contain at most 3 elements", this.InnerRep)}
+		fieldsViolations = append(fieldsViolations, fieldViolation)
+	}
+	for i, item := range this.InnerRep {
+		if fieldsViolationsChild := github_com_lucianoapolo_go_proto_validators.CallValidatorIfExists(item); fieldsViolationsChild != nil {
+			for _, fv := range fieldsViolationsChild {
+				fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: fmt.Sprintf("InnerRep[%d]", i) + "." + fv.Field, Description: fv.Description}
+				fieldsViolations = append(fieldsViolations, fieldViolation)
+			}
+		}
+	}
+	for i, item := range this.Counts {
+		if !(item > 10) {
+			fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: fmt.Sprintf("Counts[%d]", i), Description: fmt.Sprintf("value '%v' must be greater than '10'", item)}
+			fieldsViolations = append(fieldsViolations, fieldViolation)
+		}
+	}
+	if len(this.ByName) > 2 {
+		fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "ByName", Description: fmt.Sprintf("value '%v' must contain at most 2 pairs", this.ByName)}
+		fieldsViolations = append(fieldsViolations, fieldViolation)
+	}
+	for key, value := range this.ByName {
+		if !_regex_Outer_ByName_key_00.MatchString(key) {
+			fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: fmt.Sprintf("ByName[%q]", key), Description: fmt.Sprintf("value '%v' must be a string conforming to regex \"^[a-z]+$\"", key)}
+			fieldsViolations = append(fieldsViolations, fieldViolation)
+		}
+		if value != nil {
+			if fieldsViolationsChild := github_com_lucianoapolo_go_proto_validators.CallValidatorIfExists(value); fieldsViolationsChild != nil {
+				for _, fv := range fieldsViolationsChild {
+					fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: fmt.Sprintf("ByName[%q]", key) + "." + fv.Field, Description: fv.Description}
+					fieldsViolations = append(fieldsViolations, fieldViolation)
+				}
+			}
+		}
+	}
+	if this.Ratio != nil {
+		if !(*(this.Ratio) >= 0.25) {
+			fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "Ratio", Description: "Ratio must be a quarter to three quarters"}
+			fieldsViolations = append(fieldsViolations, fieldViolation)
+		}
+		if !(*(this.Ratio) <= 0.75) {
+			fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "Ratio", Description: "Ratio must be a quarter to three quarters"}
+			fieldsViolations = append(fieldsViolations, fieldViolation)
+		}
+	}
+	if len(fieldsViolations) > 0 {
+		return fieldsViolations
+	} else {
+		return nil
+	}
+}
+
+var _regex_Outer_Inner_Name_00 = regexp.MustCompile(`^[a-z]{2,5}$`)
+
+func (this *Outer_Inner) Validate() []*google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation {
+	fieldsViolations := []*google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{}
+	if this.Name != nil {
+		if !_regex_Outer_Inner_Name_00.MatchString(*(this.Name)) {
+			fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "Name", Description: fmt.Sprintf("value '%v' must be a string conforming to regex \"^[a-z]{2,5}$\"", *(this.Name))}
+			fieldsViolations = append(fieldsViolations, fieldViolation)
+		}
+	}
+	if this.Value != nil {
+		if !(*(this.Value) > 0) {
+			fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "Value", Description: fmt.Sprintf("value '%v' must be greater than '0'", *(this.Value))}
+			fieldsViolations = append(fieldsViolations, fieldViolation)
+		}
+		if !(*(this.Value) < 100) {
+			fieldViolation := &google_golang_org_genproto_googleapis_rpc_errdetails.BadRequest_FieldViolation{Field: "Value", Description: fmt.Sprintf("value '%v' must be less than '100'", *(this.Value))}
+			fieldsViolations = append(fieldsViolations, fieldViolation)
+		}
+	}
+	if len(fieldsViolations) > 0 {
+		return fieldsViolations
+	} else {
+		return nil
+	}
+}
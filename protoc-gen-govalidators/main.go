@@ -32,6 +32,10 @@ func main() {
 
 	useGogoImport := false
 	langParam := validator_plugin.LangDefault
+	fieldNamesParam := validator_plugin.FieldNamesGo
+	strict := false
+	validateErr := false
+	validateFirst := false
 
 	// Match parsing algorithm from Generator.CommandLineParameters
 	for _, parameter := range strings.Split(gen.Request.GetParameter(), ",") {
@@ -47,10 +51,35 @@ func main() {
 			if kvp[0] == "lang" {
 				langParam = strings.TrimSpace(kvp[1])
 			}
+			if kvp[0] == "field_names" {
+				fieldNamesParam = strings.TrimSpace(kvp[1])
+			}
+			if kvp[0] == "validate_err" {
+				validateErr, err = strconv.ParseBool(kvp[1])
+				if err != nil {
+					gen.Error(err, "parsing validate_err option")
+				}
+			}
+			if kvp[0] == "validate_first" {
+				validateFirst, err = strconv.ParseBool(kvp[1])
+				if err != nil {
+					gen.Error(err, "parsing validate_first option")
+				}
+			}
+			if kvp[0] == "strict" {
+				strict, err = strconv.ParseBool(kvp[1])
+				if err != nil {
+					gen.Error(err, "parsing strict option")
+				}
+			}
 		}
 	}
 
 	validator_plugin.SetLanguage(langParam)
+	validator_plugin.SetFieldNames(fieldNamesParam)
+	validator_plugin.SetStrict(strict)
+	validator_plugin.SetValidateErr(validateErr)
+	validator_plugin.SetValidateFirst(validateFirst)
 
 	gen.CommandLineParameters(gen.Request.GetParameter())
 
@@ -0,0 +1,47 @@
+package validatortest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// violationFields lists the Field of every violation.
+func violationFields(violations []*errdetails.BadRequest_FieldViolation) []string {
+	var fields []string
+	for _, violation := range violations {
+		fields = append(fields, violation.Field)
+	}
+	return fields
+}
+
+func TestProtoFieldNames_OneOfRequired(t *testing.T) {
+	example := &OneOfMessage3{SomeInt: 30}
+	assert.Equal(t, []string{"something"}, violationFields(example.Validate()), "oneof required must use the proto name of the oneof")
+}
+
+func TestProtoFieldNames_OneOfFields(t *testing.T) {
+	example := &OneOfMessage3{
+		SomeInt:   30,
+		Type:      &OneOfMessage3_TwoInt{TwoInt: 100},
+		Something: &OneOfMessage3_FiveRegex{FiveRegex: "11"},
+	}
+	assert.Equal(t, []string{"two_int", "five_regex"}, violationFields(example.Validate()), "oneof fields must use their proto names")
+}
+
+func TestProtoFieldNames_Nested(t *testing.T) {
+	example := &OneOfMessage3{
+		SomeInt:   30,
+		Type:      &OneOfMessage3_OneMsg{OneMsg: &ExternalMsg{Identifier: "999", SomeValue: 99}},
+		Something: &OneOfMessage3_FourInt{FourInt: 101},
+	}
+	assert.Equal(t, []string{"one_msg.Identifier"}, violationFields(example.Validate()), "nested paths must use proto names")
+}
+
+func TestProtoFieldNames_MsgExistsIfAnotherNot(t *testing.T) {
+	violations := (&ExistsIfAnotherNotMessage3{}).Validate()
+	assert.Equal(t, []string{"first_msg"}, violationFields(violations))
+	assert.Equal(t, "message must exist if message second_msg is not exists", violations[0].Description, "the other field must use its proto name")
+	assert.Empty(t, (&ExistsIfAnotherNotMessage3{SecondMsg: &ExternalMsg{Identifier: "ab", SomeValue: 1}}).Validate())
+}
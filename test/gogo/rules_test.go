@@ -0,0 +1,27 @@
+// Copyright 2016 Michal Witkowski. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package validatortest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	validator "github.com/lucianoapolo/go-proto-validators"
+	"github.com/lucianoapolo/go-proto-validators/test/cases"
+)
+
+// TestRules runs the shared rule cases against the generated code.
+func TestRules(t *testing.T) {
+	cases.Run(t, func(t *testing.T, message string, text string) []*errdetails.BadRequest_FieldViolation {
+		mt := proto.MessageType(message)
+		require.NotNil(t, mt, "unknown message %s", message)
+		msg := reflect.New(mt.Elem()).Interface().(proto.Message)
+		require.NoError(t, proto.UnmarshalText(text, msg))
+		return validator.CallValidatorIfExists(msg)
+	})
+}
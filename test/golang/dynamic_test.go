@@ -0,0 +1,219 @@
+package validatortest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	validator "github.com/lucianoapolo/go-proto-validators"
+	"github.com/lucianoapolo/go-proto-validators/dynamic"
+)
+
+func violationStrings(violations []*errdetails.BadRequest_FieldViolation) []string {
+	var out []string
+	for _, fv := range violations {
+		out = append(out, fv.Field+": "+fv.Description)
+	}
+	return out
+}
+
+func assertDynamicParity(t *testing.T, msg proto.Message) {
+	generated := validator.CallValidatorIfExists(msg)
+	reflected := dynamic.NewValidator(dynamic.LangDefault).Validate(msg.ProtoReflect())
+	assert.Equal(t, violationStrings(generated), violationStrings(reflected), "dynamic violations must match the generated ones")
+}
+
+func TestDynamicParity_Proto3(t *testing.T) {
+	testcases := map[string]func() *ValidatorMessage3{
+		"good": func() *ValidatorMessage3 {
+			return buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 1, 1)
+		},
+		"regex": func() *ValidatorMessage3 {
+			return buildProto3("toolong", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+		},
+		"nested regex": func() *ValidatorMessage3 {
+			return buildProto3("-%ab", 11, "bad#", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+		},
+		"int bounds": func() *ValidatorMessage3 {
+			return buildProto3("-%ab", 9, "abba", 101, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+		},
+		"strict float bounds": func() *ValidatorMessage3 {
+			return buildProto3("-%ab", 11, "abba", 99, 0.3, 0.7000001, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+		},
+		"strict float bounds with epsilon": func() *ValidatorMessage3 {
+			return buildProto3("-%ab", 11, "abba", 99, 0.300000001, 0.3000001, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+		},
+		"non-strict float bounds": func() *ValidatorMessage3 {
+			return buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.2499999, 0.75111111, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+		},
+		"empty string and element count": func() *ValidatorMessage3 {
+			return buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "", 1, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+		},
+		"length": func() *ValidatorMessage3 {
+			return buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "abc456", []byte("anc"), uuid1, uuid4, 0, 0)
+		},
+		"uuid and enum": func() *ValidatorMessage3 {
+			return buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, "1234abcd", uuid1, 2, 2)
+		},
+		"missing message and custom error": func() *ValidatorMessage3 {
+			msg := buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+			msg.SomeEmbeddedExists = nil
+			msg.CustomErrorInt = 30
+			return msg
+		},
+	}
+	for name, build := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assertDynamicParity(t, build())
+		})
+	}
+}
+
+// descriptorSet returns the FileDescriptorSet of the file and of the imports the Go registry knows, the way
+// `protoc --include_imports --descriptor_set_out` would produce it.
+func descriptorSet(file protoreflect.FileDescriptor) *descriptorpb.FileDescriptorSet {
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] || fd.IsPlaceholder() {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(file)
+	return set
+}
+
+func TestDynamic_MessageFromFileDescriptorSet(t *testing.T) {
+	data, err := proto.Marshal(descriptorSet(File_validator_proto3_proto))
+	assert.NoError(t, err)
+	set := &descriptorpb.FileDescriptorSet{}
+	assert.NoError(t, proto.Unmarshal(data, set))
+	// The validator and gogo options are not in the set, their values stay unknown fields of the options.
+	files, err := protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(set)
+	assert.NoError(t, err)
+	desc, err := files.FindDescriptorByName("validatortest.ValidatorMessage3")
+	assert.NoError(t, err)
+	md := desc.(protoreflect.MessageDescriptor)
+	assert.NotEqual(t, (&ValidatorMessage3{}).ProtoReflect().Descriptor(), md, "the descriptor must not be the generated one")
+
+	for name, generated := range map[string]*ValidatorMessage3{
+		"good":       buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 1, 1),
+		"regex":      buildProto3("toolong", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0),
+		"int bounds": buildProto3("-%ab", 9, "abba", 101, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0),
+		"length":     buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "abc456", []byte("anc"), uuid1, uuid4, 0, 0),
+	} {
+		t.Run(name, func(t *testing.T) {
+			wire, err := proto.Marshal(generated)
+			assert.NoError(t, err)
+			msg := dynamicpb.NewMessage(md)
+			assert.NoError(t, proto.Unmarshal(wire, msg))
+			reflected := dynamic.NewValidator(dynamic.LangDefault).Validate(msg)
+			assert.Equal(t, violationStrings(generated.Validate()), violationStrings(reflected))
+			if name == "good" {
+				assert.Empty(t, reflected)
+			} else {
+				assert.NotEmpty(t, reflected)
+			}
+		})
+	}
+}
+
+func TestDynamicParity_Proto2(t *testing.T) {
+	testcases := map[string]func() *ValidatorMessage{
+		"good": func() *ValidatorMessage {
+			return buildProto2("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 1, 1)
+		},
+		"regex": func() *ValidatorMessage {
+			return buildProto2("toolong", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+		},
+		"int bounds": func() *ValidatorMessage {
+			return buildProto2("-%ab", 9, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+		},
+		"float bounds": func() *ValidatorMessage {
+			return buildProto2("-%ab", 11, "abba", 99, 0.3, 0.7000001, 0.2499999, 0.75111111, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+		},
+		"empty string and element count": func() *ValidatorMessage {
+			return buildProto2("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "", 14, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+		},
+		"uuid and enum": func() *ValidatorMessage {
+			return buildProto2("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "abc456", []byte("anc"), "1234abcd", uuid1, 2, 2)
+		},
+		"nested messages": func() *ValidatorMessage {
+			msg := buildProto2("-%ab", 11, "bad#", 101, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+			msg.SomeGogoEmbedded = &ValidatorMessage_EmbeddedMessage{}
+			return msg
+		},
+		"unset optional fields": func() *ValidatorMessage {
+			msg := buildProto2("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+			msg.StringReq = nil
+			msg.IntReq = nil
+			msg.StringReqNonNull = nil
+			msg.IntReqNonNull = nil
+			return msg
+		},
+	}
+	for name, build := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assertDynamicParity(t, build())
+		})
+	}
+}
+
+func TestDynamicParity_OneOf(t *testing.T) {
+	assertDynamicParity(t, &OneOfMessage3{SomeInt: 30})
+	assertDynamicParity(t, &OneOfMessage3{
+		SomeInt:   30,
+		Type:      &OneOfMessage3_OneMsg{OneMsg: &ExternalMsg{Identifier: "999", SomeValue: 99}},
+		Something: &OneOfMessage3_ThreeInt{ThreeInt: 19},
+	})
+	assertDynamicParity(t, &OneOfMessage3{
+		SomeInt:   3,
+		Type:      &OneOfMessage3_TwoInt{TwoInt: 100},
+		Something: &OneOfMessage3_FiveRegex{FiveRegex: "11"},
+	})
+}
+
+func TestDynamicParity_Map(t *testing.T) {
+	assertDynamicParity(t, &ValidatorMapMessage3{})
+	assertDynamicParity(t, &ValidatorMapMessage3{
+		SomeExtMap:        map[string]*ValueType{"abc": {Something: ""}},
+		SomeBoundedMap:    map[string]int64{"ab": 1, "bc": 2, "cd": 3, "de": 4},
+		SomeKeyBoundedMap: map[int32]*ValueType{-1: {Something: "x"}},
+	})
+	assertDynamicParity(t, &ValidatorMapMessage3{
+		SomeNestedMap:     map[int32]*ValidatorMapMessage3_NestedType{3: {Something: "toolong"}},
+		SomeBoundedMap:    map[string]int64{"ABC": 0},
+		SomeKeyBoundedMap: map[int32]*ValueType{1: {Something: "x"}},
+	})
+	assertDynamicParity(t, &ValidatorMapMessage3{
+		SomeExtMap:        map[string]*ValueType{"abc": nil},
+		SomeKeyBoundedMap: map[int32]*ValueType{-1: nil},
+	})
+	assertDynamicParity(t, &ValidatorMapMessage3{
+		SomeKeyBoundedMap:    map[int32]*ValueType{1: {Something: "x"}},
+		SomeRequiredValueMap: map[string]*ValueType{"abc": {}, "def": nil},
+	})
+}
+
+func TestDynamicParity_NilRepeatedElements(t *testing.T) {
+	assertDynamicParity(t, &WrapperMessage3{Age: &wrapperspb.Int64Value{}, Tags: []*wrapperspb.StringValue{nil, {}}})
+	assertDynamicParity(t, &TimestampMessage3{History: []*timestamppb.Timestamp{nil, {Nanos: -1}}})
+}
+
+func TestDynamicParity_ValidUTF8(t *testing.T) {
+	assertDynamicParity(t, &RuneMessage3{Name: "Joãozinho", Code: "", Text: "S\xe3o Paulo"})
+}
@@ -0,0 +1,70 @@
+package validatortest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	validator "github.com/lucianoapolo/go-proto-validators"
+)
+
+func invalidNestedMessage() *OneOfMessage3 {
+	return &OneOfMessage3{
+		SomeInt:   3,
+		Type:      &OneOfMessage3_OneMsg{OneMsg: &ExternalMsg{Identifier: "999", SomeValue: 100}},
+		Something: &OneOfMessage3_FiveRegex{FiveRegex: "11"},
+	}
+}
+
+func TestValidateErr_Valid(t *testing.T) {
+	assert.NoError(t, validOneOfMessage().ValidateErr(), "valid messages must not return an error")
+	assert.Nil(t, validator.NewValidationError(nil))
+}
+
+func TestValidateErr_ErrorsAs(t *testing.T) {
+	err := fmt.Errorf("creating resource: %w", invalidNestedMessage().ValidateErr())
+
+	var validationErr *validator.ValidationError
+	require.True(t, errors.As(err, &validationErr), "wrapped validation errors must be found by errors.As")
+	assert.Equal(t, []string{"SomeInt", "OneMsg.Identifier", "OneMsg.SomeValue", "FiveRegex"}, violationFields(validationErr.Violations))
+	assert.Contains(t, err.Error(), "invalid field OneMsg.Identifier: value '999' must be a string conforming to regex")
+}
+
+func TestValidationError_Status(t *testing.T) {
+	err := invalidNestedMessage().ValidateErr()
+
+	st, ok := status.FromError(err)
+	require.True(t, ok, "validation errors must convert to a gRPC status")
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, err.Error(), st.Message())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok, "status details must be an errdetails.BadRequest")
+	assert.Len(t, badRequest.FieldViolations, 4)
+}
+
+func TestValidationError_BadRequest(t *testing.T) {
+	violations := invalidNestedMessage().Validate()
+	validationErr := &validator.ValidationError{Violations: violations}
+	assert.Equal(t, violations, validationErr.BadRequest().FieldViolations)
+}
+
+func TestValidationError_Filter(t *testing.T) {
+	validationErr := &validator.ValidationError{Violations: []*errdetails.BadRequest_FieldViolation{
+		{Field: "Inner", Description: "message must exist"},
+		{Field: "Inner.SomeValue", Description: "too big"},
+		{Field: "Inner[2].Identifier", Description: "bad identifier"},
+		{Field: "InnerValue", Description: "too small"},
+	}}
+
+	assert.Equal(t, []string{"Inner", "Inner.SomeValue", "Inner[2].Identifier"}, violationFields(validationErr.Filter("Inner").Violations))
+	assert.Equal(t, []string{"Inner[2].Identifier"}, violationFields(validationErr.Filter("Inner[2]").Violations))
+	assert.Len(t, validationErr.Filter("").Violations, 4, "the empty prefix must keep every violation")
+	assert.Nil(t, validationErr.Filter("Outer"), "filtering out every violation must return nil")
+}
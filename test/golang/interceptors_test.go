@@ -0,0 +1,242 @@
+package validatortest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	validator "github.com/lucianoapolo/go-proto-validators"
+)
+
+const (
+	unaryMethod  = "/validatortest.TestService/Unary"
+	streamMethod = "/validatortest.TestService/Stream"
+)
+
+// testServiceDesc describes an echo service exchanging OneOfMessage3, written by hand to avoid generating gRPC stubs.
+var testServiceDesc = grpc.ServiceDesc{
+	ServiceName: "validatortest.TestService",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Unary",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(OneOfMessage3)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				echo := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return req, nil
+				}
+				if interceptor == nil {
+					return echo(ctx, in)
+				}
+				return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: unaryMethod}, echo)
+			},
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName: "Stream",
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				for {
+					in := new(OneOfMessage3)
+					if err := stream.RecvMsg(in); err != nil {
+						if err == io.EOF {
+							return nil
+						}
+						return err
+					}
+					if err := stream.SendMsg(in); err != nil {
+						return err
+					}
+				}
+			},
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+}
+
+func dialTestService(t *testing.T, serverOpts []grpc.ServerOption, dialOpts ...grpc.DialOption) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(serverOpts...)
+	server.RegisterService(&testServiceDesc, struct{}{})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+	dialOpts = append(dialOpts, grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	conn, err := grpc.Dial("bufnet", dialOpts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func validOneOfMessage() *OneOfMessage3 {
+	return &OneOfMessage3{
+		SomeInt:   30,
+		Type:      &OneOfMessage3_OneMsg{OneMsg: &ExternalMsg{Identifier: "abba", SomeValue: 99}},
+		Something: &OneOfMessage3_FourInt{FourInt: 101},
+	}
+}
+
+func invalidOneOfMessage() *OneOfMessage3 {
+	return &OneOfMessage3{
+		SomeInt:   30,
+		Type:      &OneOfMessage3_OneMsg{OneMsg: &ExternalMsg{Identifier: "abba", SomeValue: 99}},
+		Something: &OneOfMessage3_FiveRegex{FiveRegex: "11"},
+	}
+}
+
+func callUnary(conn *grpc.ClientConn, req *OneOfMessage3) (*OneOfMessage3, error) {
+	resp := new(OneOfMessage3)
+	err := conn.Invoke(context.Background(), unaryMethod, req, resp)
+	return resp, err
+}
+
+// callStream sends req on a new stream and returns the error of the echoed message.
+func callStream(t *testing.T, conn *grpc.ClientConn, req *OneOfMessage3) error {
+	stream, err := conn.NewStream(context.Background(), &testServiceDesc.Streams[0], streamMethod)
+	require.NoError(t, err)
+	if err := stream.SendMsg(req); err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	return stream.RecvMsg(new(OneOfMessage3))
+}
+
+func assertInvalidArgument(t *testing.T, err error, field string) *status.Status {
+	st, ok := status.FromError(err)
+	require.True(t, ok, "error must be a gRPC status")
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1, "status must carry the violations")
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok, "status details must be an errdetails.BadRequest")
+	require.NotEmpty(t, badRequest.FieldViolations)
+	assert.Equal(t, field, badRequest.FieldViolations[0].Field)
+	return st
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	conn := dialTestService(t, []grpc.ServerOption{grpc.UnaryInterceptor(validator.UnaryServerInterceptor())})
+
+	resp, err := callUnary(conn, validOneOfMessage())
+	assert.NoError(t, err, "valid requests must reach the handler")
+	assert.Equal(t, uint32(101), resp.GetFourInt())
+
+	_, err = callUnary(conn, invalidOneOfMessage())
+	assertInvalidArgument(t, err, "FiveRegex")
+}
+
+func TestUnaryServerInterceptor_SkippedMethods(t *testing.T) {
+	interceptor := validator.UnaryServerInterceptor(validator.WithSkippedMethods(unaryMethod))
+	conn := dialTestService(t, []grpc.ServerOption{grpc.UnaryInterceptor(interceptor)})
+
+	_, err := callUnary(conn, invalidOneOfMessage())
+	assert.NoError(t, err, "skipped methods must not be validated")
+}
+
+func TestUnaryServerInterceptor_StatusMessage(t *testing.T) {
+	statusMessage := func(fullMethod string, violations []*errdetails.BadRequest_FieldViolation) string {
+		var fields []string
+		for _, violation := range violations {
+			fields = append(fields, violation.Field)
+		}
+		return fullMethod + ": invalid " + strings.Join(fields, ", ")
+	}
+	interceptor := validator.UnaryServerInterceptor(validator.WithStatusMessage(statusMessage))
+	conn := dialTestService(t, []grpc.ServerOption{grpc.UnaryInterceptor(interceptor)})
+
+	_, err := callUnary(conn, invalidOneOfMessage())
+	st := assertInvalidArgument(t, err, "FiveRegex")
+	assert.Equal(t, unaryMethod+": invalid FiveRegex", st.Message())
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	conn := dialTestService(t, []grpc.ServerOption{grpc.StreamInterceptor(validator.StreamServerInterceptor())})
+
+	assert.NoError(t, callStream(t, conn, validOneOfMessage()), "valid messages must reach the handler")
+	assertInvalidArgument(t, callStream(t, conn, invalidOneOfMessage()), "FiveRegex")
+}
+
+func TestStreamServerInterceptor_SkippedMethods(t *testing.T) {
+	interceptor := validator.StreamServerInterceptor(validator.WithSkippedMethods(streamMethod))
+	conn := dialTestService(t, []grpc.ServerOption{grpc.StreamInterceptor(interceptor)})
+
+	assert.NoError(t, callStream(t, conn, invalidOneOfMessage()), "skipped methods must not be validated")
+}
+
+// rejectingServerOptions make the server fail every call, proving that a request never left the client.
+func rejectingServerOptions() []grpc.ServerOption {
+	reject := status.Error(codes.Unavailable, "request reached the server")
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(func(context.Context, interface{}, *grpc.UnaryServerInfo, grpc.UnaryHandler) (interface{}, error) {
+			return nil, reject
+		}),
+		grpc.StreamInterceptor(func(interface{}, grpc.ServerStream, *grpc.StreamServerInfo, grpc.StreamHandler) error {
+			return reject
+		}),
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	conn := dialTestService(t, rejectingServerOptions(), grpc.WithUnaryInterceptor(validator.UnaryClientInterceptor()))
+
+	_, err := callUnary(conn, invalidOneOfMessage())
+	assertInvalidArgument(t, err, "FiveRegex")
+
+	_, err = callUnary(conn, validOneOfMessage())
+	assert.Equal(t, codes.Unavailable, status.Code(err), "valid requests must be sent to the server")
+}
+
+func TestUnaryClientInterceptor_LogOnly(t *testing.T) {
+	var logged []string
+	logf := func(format string, args ...interface{}) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	}
+	interceptor := validator.UnaryClientInterceptor(validator.WithLogOnly(logf))
+	conn := dialTestService(t, nil, grpc.WithUnaryInterceptor(interceptor))
+
+	_, err := callUnary(conn, invalidOneOfMessage())
+	assert.NoError(t, err, "log only mode must let invalid requests through")
+	require.Len(t, logged, 1)
+	assert.Contains(t, logged[0], unaryMethod)
+	assert.Contains(t, logged[0], "InvalidArgument")
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	conn := dialTestService(t, rejectingServerOptions(), grpc.WithStreamInterceptor(validator.StreamClientInterceptor()))
+
+	stream, err := conn.NewStream(context.Background(), &testServiceDesc.Streams[0], streamMethod)
+	require.NoError(t, err)
+	assertInvalidArgument(t, stream.SendMsg(invalidOneOfMessage()), "FiveRegex")
+}
+
+func TestStreamClientInterceptor_LogOnly(t *testing.T) {
+	var logged []string
+	logf := func(format string, args ...interface{}) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	}
+	interceptor := validator.StreamClientInterceptor(validator.WithLogOnly(logf))
+	conn := dialTestService(t, nil, grpc.WithStreamInterceptor(interceptor))
+
+	assert.NoError(t, callStream(t, conn, invalidOneOfMessage()), "log only mode must let invalid messages through")
+	require.Len(t, logged, 1)
+	assert.Contains(t, logged[0], streamMethod)
+}
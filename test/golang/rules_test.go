@@ -0,0 +1,35 @@
+// Copyright 2016 Michal Witkowski. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package validatortest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	validator "github.com/lucianoapolo/go-proto-validators"
+	"github.com/lucianoapolo/go-proto-validators/dynamic"
+	"github.com/lucianoapolo/go-proto-validators/test/cases"
+)
+
+// TestRules runs the shared rule cases against the generated code, checking the dynamic validator reports the same
+// violations.
+func TestRules(t *testing.T) {
+	cases.Run(t, func(t *testing.T, message string, text string) []*errdetails.BadRequest_FieldViolation {
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(message))
+		require.NoError(t, err)
+		msg := mt.New().Interface()
+		require.NoError(t, prototext.Unmarshal([]byte(text), msg))
+
+		generated := validator.CallValidatorIfExists(msg)
+		reflected := dynamic.NewValidator(dynamic.LangDefault).Validate(msg.ProtoReflect())
+		assert.Equal(t, violationStrings(generated), violationStrings(reflected), "dynamic violations must match the generated ones")
+		return generated
+	})
+}
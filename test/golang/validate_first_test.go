@@ -0,0 +1,77 @@
+package validatortest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFirst_MatchesValidate(t *testing.T) {
+	testcases := map[string]*ValidatorMessage3{
+		"regex":          buildProto3("toolong", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0),
+		"nested regex":   buildProto3("-%ab", 11, "bad#", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0),
+		"int bounds":     buildProto3("-%ab", 9, "abba", 101, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0),
+		"float bounds":   buildProto3("-%ab", 11, "abba", 99, 0.3, 0.7000001, 0.2499999, 0.75111111, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0),
+		"length":         buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "abc456", []byte("anc"), uuid1, uuid4, 0, 0),
+		"uuid and enum":  buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, "1234abcd", uuid1, 2, 2),
+		"repeated count": buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "", 1, "1234567890", stableBytes, uuid1, uuid4, 0, 0),
+	}
+	for name, msg := range testcases {
+		t.Run(name, func(t *testing.T) {
+			violations := msg.Validate()
+			require.NotEmpty(t, violations)
+			first := msg.ValidateFirst()
+			require.NotNil(t, first)
+			assert.Equal(t, violations[0].Field, first.Field, "ValidateFirst must return the first violation of Validate")
+			assert.Equal(t, violations[0].Description, first.Description, "ValidateFirst must return the first violation of Validate")
+		})
+	}
+}
+
+func TestValidateFirst_Valid(t *testing.T) {
+	msg := buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 1, 1)
+	assert.Nil(t, msg.ValidateFirst())
+	assert.Nil(t, validOneOfMessage().ValidateFirst())
+}
+
+func TestValidateFirst_NestedPath(t *testing.T) {
+	msg := &OneOfMessage3{
+		SomeInt:   30,
+		Type:      &OneOfMessage3_OneMsg{OneMsg: &ExternalMsg{Identifier: "abba", SomeValue: 100}},
+		Something: &OneOfMessage3_FourInt{FourInt: 101},
+	}
+	assert.Equal(t, "OneMsg.SomeValue", msg.ValidateFirst().Field)
+}
+
+func BenchmarkValidate_Valid(b *testing.B) {
+	msg := buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 1, 1)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		msg.Validate()
+	}
+}
+
+func BenchmarkValidateFirst_Valid(b *testing.B) {
+	msg := buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 1, 1)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		msg.ValidateFirst()
+	}
+}
+
+func BenchmarkValidate_Invalid(b *testing.B) {
+	msg := buildProto3("toolong", 9, "bad#", 101, 0.3, 0.7000001, 0.2499999, 0.75111111, "", 1, "abc456", []byte("anc"), "1234abcd", uuid1, 2, 2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		msg.Validate()
+	}
+}
+
+func BenchmarkValidateFirst_Invalid(b *testing.B) {
+	msg := buildProto3("toolong", 9, "bad#", 101, 0.3, 0.7000001, 0.2499999, 0.75111111, "", 1, "abc456", []byte("anc"), "1234abcd", uuid1, 2, 2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		msg.ValidateFirst()
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
 var (
@@ -20,6 +21,23 @@ const (
 	uuid1 = "66bb25e2-2e0d-11e9-b210-d663bd873d93"
 )
 
+// fieldErrorString renders the first violation as "invalid field <Field>: <Description>".
+func fieldErrorString(violations []*errdetails.BadRequest_FieldViolation) string {
+	if len(violations) == 0 {
+		return ""
+	}
+	return "invalid field " + violations[0].Field + ": " + violations[0].Description
+}
+
+// violationFields lists the Field of every violation.
+func violationFields(violations []*errdetails.BadRequest_FieldViolation) []string {
+	var fields []string
+	for _, violation := range violations {
+		fields = append(fields, violation.Field)
+	}
+	return fields
+}
+
 func buildProto3(someString string, someInt uint32, identifier string, someValue int64, someDoubleStrict float64,
 	someFloatStrict float32, someDouble float64, someFloat float32, nonEmptyString string, repeatedCount uint32,
 	someStringLength string, someBytes []byte,
@@ -155,19 +173,17 @@ func buildProto2(someString string, someInt uint32, identifier string,
 }
 
 func TestGoodProto3(t *testing.T) {
-	var err error
 	goodProto3 := buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 1, 1)
-	err = goodProto3.Validate()
+	err := goodProto3.Validate()
 	if err != nil {
 		t.Fatalf("unexpected fail in validator: %v", err)
 	}
 }
 
 func TestGoodProto2(t *testing.T) {
-	var err error
 	goodProto2 := buildProto2("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 1, 1)
 
-	err = goodProto2.Validate()
+	err := goodProto2.Validate()
 	if err != nil {
 		t.Fatalf("unexpected fail in validator: %v", err)
 	}
@@ -430,7 +446,7 @@ func TestMsgExist(t *testing.T) {
 	someProto3.SomeEmbeddedExists = nil
 	if err := someProto3.Validate(); err == nil {
 		t.Fatalf("expected fail due to lacking SomeEmbeddedExists")
-	} else if !strings.HasPrefix(err.Error(), "invalid field SomeEmbeddedExists:") {
+	} else if !strings.HasPrefix(fieldErrorString(err), "invalid field SomeEmbeddedExists:") {
 		t.Fatalf("expected fieldError, got '%v'", err)
 	}
 }
@@ -482,11 +498,41 @@ func TestNestedError3(t *testing.T) {
 	someProto3.SomeEmbeddedExists.SomeValue = 101 // should be less than 101
 	if err := someProto3.Validate(); err == nil {
 		t.Fatalf("expected fail due to nested SomeEmbeddedNonNullable.SomeValue being wrong")
-	} else if !strings.HasPrefix(err.Error(), "invalid field SomeEmbeddedNonNullable.SomeValue:") {
+	} else if !strings.HasPrefix(fieldErrorString(err), "invalid field SomeEmbeddedNonNullable.SomeValue:") {
 		t.Fatalf("expected fieldError, got '%v'", err)
 	}
 }
 
+func TestNestedFieldPaths_Proto2(t *testing.T) {
+	someProto2 := buildProto2("-%ab", 11, "abba", 101, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+	assert.Equal(t, []string{
+		"EmbeddedReq.SomeValue",
+		"EmbeddedNonNull.SomeValue",
+		"EmbeddedRep[0].SomeValue",
+		"EmbeddedRepNonNullable[0].SomeValue",
+	}, violationFields(someProto2.Validate()), "nested violations must be prefixed by the path of their message")
+
+	badIdentifier := "bad#"
+	someProto2.SomeGogoEmbedded = &ValidatorMessage_EmbeddedMessage{Identifier: &badIdentifier}
+	assert.Equal(t, "SomeGogoEmbedded.Identifier", violationFields(someProto2.Validate())[4], "set non-nullable messages must be validated")
+}
+
+func TestRepeatedIndexedFieldPaths(t *testing.T) {
+	someProto3 := buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
+	someProto3.SomeStringRep = []string{"abc", "toolong"}
+	someProto3.SomeIntRep = []uint32{11, 12, 13, 1}
+	someProto3.SomeEmbeddedRep = []*ValidatorMessage3_EmbeddedMessage{
+		{Identifier: "abba", SomeValue: 99},
+		{Identifier: "abba", SomeValue: 99},
+		{Identifier: "bad#", SomeValue: 99},
+	}
+	assert.Equal(t, []string{
+		"SomeStringRep[1]",
+		"SomeIntRep[3]",
+		"SomeEmbeddedRep[2].Identifier",
+	}, violationFields(someProto3.Validate()), "repeated violations must carry the index of the failing element")
+}
+
 func TestCustomError_Proto3(t *testing.T) {
 	someProto3 := buildProto3("-%ab", 11, "abba", 99, 0.5, 0.5, 0.5, 0.5, "x", 4, "1234567890", stableBytes, uuid1, uuid4, 0, 0)
 
@@ -494,16 +540,100 @@ func TestCustomError_Proto3(t *testing.T) {
 	expectedErr := "invalid field CustomErrorInt: My Custom Error"
 	if err := someProto3.Validate(); err == nil {
 		t.Fatalf("validate should fail on missing CustomErrorInt")
-	} else if err.Error() != expectedErr {
-		t.Fatalf("validation error should be '%s' but was '%s'", expectedErr, err.Error())
+	} else if fieldErrorString(err) != expectedErr {
+		t.Fatalf("validation error should be '%s' but was '%s'", expectedErr, fieldErrorString(err))
 	}
 }
 
-func TestMapAlwaysPassesUntilFixedProperly(t *testing.T) {
+func TestMap_EmptyUnboundedMapsPass(t *testing.T) {
+	example := &ValidatorMapMessage3{
+		SomeStringMap:     map[string]string{},
+		SomeBoundedMap:    map[string]int64{},
+		SomeKeyBoundedMap: map[int32]*ValueType{1: {Something: "x"}},
+	}
+	assert.Empty(t, example.Validate(), "empty maps without a pair count minimum should pass")
+}
+
+func TestMap_NestedMessageValues(t *testing.T) {
+	example := &ValidatorMapMessage3{
+		SomeExtMap:        map[string]*ValueType{"abc": {Something: ""}},
+		SomeNestedMap:     map[int32]*ValidatorMapMessage3_NestedType{3: {Something: "toolong"}},
+		SomeKeyBoundedMap: map[int32]*ValueType{1: {Something: "x"}},
+	}
+	err := example.Validate()
+	assert.Len(t, err, 2)
+	fields := []string{err[0].Field, err[1].Field}
+	assert.Contains(t, fields, `SomeExtMap["abc"].Something`, "error must carry the map key in the field path")
+	assert.Contains(t, fields, `SomeNestedMap[3].Something`, "error must carry the map key in the field path")
+}
+
+func TestMap_PairCount(t *testing.T) {
 	example := &ValidatorMapMessage3{}
-	if err := example.Validate(); err != nil {
-		t.Fatalf("map validators should always pass")
+	err := example.Validate()
+	assert.Len(t, err, 1)
+	assert.Equal(t, "SomeKeyBoundedMap", err[0].Field, "map_count_min should fail on an empty map")
+
+	example = &ValidatorMapMessage3{
+		SomeBoundedMap:    map[string]int64{"ab": 1, "bc": 2, "cd": 3, "de": 4},
+		SomeKeyBoundedMap: map[int32]*ValueType{1: {Something: "x"}},
+	}
+	err = example.Validate()
+	assert.Len(t, err, 1)
+	assert.Equal(t, "SomeBoundedMap", err[0].Field, "map_count_max should fail on a map with too many pairs")
+}
+
+func TestMap_KeysAndValues(t *testing.T) {
+	example := &ValidatorMapMessage3{
+		SomeBoundedMap:    map[string]int64{"abc": 0},
+		SomeKeyBoundedMap: map[int32]*ValueType{-1: {Something: "x"}},
+	}
+	err := example.Validate()
+	assert.Len(t, err, 2)
+	assert.Equal(t, `SomeBoundedMap["abc"]`, err[0].Field, "map_value should fail on a non-positive value")
+	assert.Equal(t, `SomeKeyBoundedMap[-1]`, err[1].Field, "map_key should fail on a negative key")
+
+	example = &ValidatorMapMessage3{
+		SomeBoundedMap:    map[string]int64{"ABC": 1},
+		SomeKeyBoundedMap: map[int32]*ValueType{1: {Something: "x"}},
 	}
+	err = example.Validate()
+	assert.Len(t, err, 1)
+	assert.Equal(t, `SomeBoundedMap["ABC"]`, err[0].Field, "map_key should fail on a key not conforming to regex")
+
+	example = &ValidatorMapMessage3{
+		SomeBoundedMap:    map[string]int64{"abc": 1, "de": 2},
+		SomeKeyBoundedMap: map[int32]*ValueType{0: {Something: "x"}},
+	}
+	assert.Empty(t, example.Validate(), "This message should pass all validation")
+}
+
+func TestMap_RequiredValues(t *testing.T) {
+	example := &ValidatorMapMessage3{
+		SomeKeyBoundedMap:    map[int32]*ValueType{1: {Something: "x"}},
+		SomeRequiredValueMap: map[string]*ValueType{"abc": {Something: "x"}, "def": nil},
+	}
+	err := example.Validate()
+	assert.Equal(t, []string{`SomeRequiredValueMap["def"]`}, violationFields(err), "map_value msg_exists should fail on a nil value")
+	assert.Equal(t, "message must exist", err[0].Description)
+}
+
+func TestMap_Proto2(t *testing.T) {
+	identifier, someValue, tooLarge := "abc", int64(10), int64(100)
+	example := &ValidatorMapMessage2{
+		ByName: map[string]*ValidatorMessage_EmbeddedMessage{"abc": {Identifier: &identifier, SomeValue: &someValue}},
+		Scores: map[int32]int64{1: 0},
+	}
+	assert.Empty(t, example.Validate(), "This message should pass all validation")
+
+	example = &ValidatorMapMessage2{
+		ByName: map[string]*ValidatorMessage_EmbeddedMessage{
+			"abc": {Identifier: &identifier, SomeValue: &tooLarge},
+			"ABC": {Identifier: &identifier, SomeValue: &someValue},
+		},
+		Scores: map[int32]int64{3: -1},
+	}
+	assert.ElementsMatch(t, []string{`ByName["ABC"]`, `ByName["abc"].SomeValue`, `Scores[3]`}, violationFields(example.Validate()),
+		"proto2 map violations must carry the map key in the field path")
 }
 
 func TestOneOf_Required(t *testing.T) {
@@ -511,8 +641,8 @@ func TestOneOf_Required(t *testing.T) {
 		SomeInt: 30,
 	}
 	err := example.Validate()
-	assert.Error(t, err, "oneof.required should fail if none of the oneof fields are set")
-	assert.Contains(t, err.Error(), "Something", "error must err on the Something field")
+	assert.NotEmpty(t, err, "oneof.required should fail if none of the oneof fields are set")
+	assert.Contains(t, fieldErrorString(err), "Something", "error must err on the Something field")
 }
 
 func TestOneOf_NestedMessage(t *testing.T) {
@@ -529,8 +659,8 @@ func TestOneOf_NestedMessage(t *testing.T) {
 		},
 	}
 	err := example.Validate()
-	assert.Error(t, err, "nested message in oneof should fail validation on ExternalMsg")
-	assert.Contains(t, err.Error(), "OneMsg.Identifier", "error must err on the ExternalMsg.Identifier")
+	assert.NotEmpty(t, err, "nested message in oneof should fail validation on ExternalMsg")
+	assert.Contains(t, fieldErrorString(err), "OneMsg.Identifier", "error must err on the ExternalMsg.Identifier")
 }
 
 func TestOneOf_NestedInt(t *testing.T) {
@@ -547,8 +677,8 @@ func TestOneOf_NestedInt(t *testing.T) {
 		},
 	}
 	err := example.Validate()
-	assert.Error(t, err, "nested message in oneof should fail validation on ThreeInt")
-	assert.Contains(t, err.Error(), "ThreeInt", "error must err on the ThreeInt.ThreeInt")
+	assert.NotEmpty(t, err, "nested message in oneof should fail validation on ThreeInt")
+	assert.Contains(t, fieldErrorString(err), "ThreeInt", "error must err on the ThreeInt.ThreeInt")
 }
 
 func TestOneOf_Passes(t *testing.T) {
@@ -565,7 +695,7 @@ func TestOneOf_Passes(t *testing.T) {
 		},
 	}
 	err := example.Validate()
-	assert.NoError(t, err, "This message should pass all validation")
+	assert.Empty(t, err, "This message should pass all validation")
 }
 
 func TestOneOf_Regex(t *testing.T) {
@@ -576,8 +706,8 @@ func TestOneOf_Regex(t *testing.T) {
 		},
 	}
 	err := example.Validate()
-	assert.Error(t, err, "regex applied to oneof field should fail validation on FiveRegex")
-	assert.Contains(t, err.Error(), "FiveRegex", "error must err on the FiveRegex")
+	assert.NotEmpty(t, err, "regex applied to oneof field should fail validation on FiveRegex")
+	assert.Contains(t, fieldErrorString(err), "FiveRegex", "error must err on the FiveRegex")
 
 	example = &OneOfMessage3{
 		SomeInt: 30,
@@ -586,7 +716,7 @@ func TestOneOf_Regex(t *testing.T) {
 		},
 	}
 	err = example.Validate()
-	assert.NoError(t, err, "This message should pass all validation")
+	assert.Empty(t, err, "This message should pass all validation")
 }
 
 func TestUUID4Validation(t *testing.T) {
@@ -638,3 +768,9 @@ func TestUUID4Validation(t *testing.T) {
 		})
 	}
 }
+
+// TestRunes_ValidUTF8 is not among the shared rule cases because the text format cannot hold invalid UTF-8.
+func TestRunes_ValidUTF8(t *testing.T) {
+	example := &RuneMessage3{Name: "João", Code: "abc", Text: "S\xe3o Paulo"}
+	assert.Equal(t, []string{"Text"}, violationFields(example.Validate()))
+}
@@ -0,0 +1,54 @@
+package validator
+
+import (
+	"fmt"
+	"time"
+)
+
+// Now returns the time the timestamp rules relative to the current time compare with. Tests can replace it to
+// validate at a fixed time.
+var Now = time.Now
+
+const (
+	// minTimestampSeconds is 0001-01-01T00:00:00Z and maxTimestampSeconds is 9999-12-31T23:59:59Z, the range of
+	// google.protobuf.Timestamp.
+	minTimestampSeconds = -62135596800
+	maxTimestampSeconds = 253402300799
+)
+
+// IsValidTimestamp tells whether the seconds and nanos of a google.protobuf.Timestamp are in their valid range.
+func IsValidTimestamp(seconds int64, nanos int32) bool {
+	return seconds >= minTimestampSeconds && seconds <= maxTimestampSeconds && nanos >= 0 && nanos <= 999999999
+}
+
+// CompareTimestamp returns -1, 0 or +1 depending on whether the timestamp is before, equal to or after the other one.
+func CompareTimestamp(seconds int64, nanos int32, otherSeconds int64, otherNanos int32) int {
+	switch {
+	case seconds < otherSeconds || seconds == otherSeconds && nanos < otherNanos:
+		return -1
+	case seconds > otherSeconds || nanos > otherNanos:
+		return 1
+	}
+	return 0
+}
+
+// CompareTimestampToNow compares the timestamp with Now, see CompareTimestamp.
+func CompareTimestampToNow(seconds int64, nanos int32) int {
+	now := Now()
+	return CompareTimestamp(seconds, nanos, now.Unix(), int32(now.Nanosecond()))
+}
+
+// IsTimestampWithin tells whether the timestamp is at most within before or after Now.
+func IsTimestampWithin(seconds int64, nanos int32, within time.Duration) bool {
+	distance := time.Unix(seconds, int64(nanos)).Sub(Now())
+	return distance >= -within && distance <= within
+}
+
+// FormatTimestamp formats the timestamp in RFC 3339, e.g. 2020-01-02T15:04:05.5Z, or as its seconds and nanos when
+// it is out of range.
+func FormatTimestamp(seconds int64, nanos int32) string {
+	if !IsValidTimestamp(seconds, nanos) {
+		return fmt.Sprintf("seconds:%d nanos:%d", seconds, nanos)
+	}
+	return time.Unix(seconds, int64(nanos)).UTC().Format(time.RFC3339Nano)
+}